@@ -0,0 +1,766 @@
+// 由 `websocket_server schema haxe` 生成，请勿手动修改
+package hxonline;
+
+class Protocol {
+	public static inline var VERSION:Int = 7;
+}
+
+enum abstract ClientAction(Int) from Int to Int {
+	/** 通用错误，发生错误时，Data请传递`ClientError`结构体 **/
+	var Error = -1;
+	/** 普通消息 **/
+	var Message = 0;
+	/** 创建房间 **/
+	var CreateRoom = 1;
+	/** 加入房间 **/
+	var JoinRoom = 2;
+	/** 房间信息变更 **/
+	var ChangedRoom = 3;
+	/** 获取房间信息 **/
+	var GetRoomData = 4;
+	/** 开启帧同步 **/
+	var StartFrameSync = 5;
+	/** 停止帧同步 **/
+	var StopFrameSync = 6;
+	/** 上传帧同步数据 **/
+	var UploadFrame = 7;
+	/** 登陆用户 **/
+	var Login = 8;
+	/** 帧数据 **/
+	var FData = 9;
+	/** 发送房间消息 **/
+	var RoomMessage = 10;
+	/** 加入房间的客户端信息 **/
+	var JoinRoomClient = 11;
+	/** 退出房间的客户端信息 **/
+	var ExitRoomClient = 12;
+	/** 在房间中离线的客户端信息，请注意，只有开启了帧同步的情况下收到 **/
+	var OutOnlineRoomClient = 13;
+	/** 退出房间 **/
+	var ExitRoom = 14;
+	/** 匹配用户 **/
+	var MatchUser = 15;
+	/** 更新用户数据 **/
+	var UpdateUserData = 16;
+	/** 获取房间的历史消息 **/
+	var GetRoomOldMessage = 17;
+	/** 更新自定义房间信息（房主操作） **/
+	var UpdateRoomCustomData = 18;
+	/** 更新房间的配置，如人数、密码等（房主操作） **/
+	var UpdateRoomOption = 19;
+	/** 踢出用户（房主操作） **/
+	var KickOut = 20;
+	/** 自已被踢出房间 **/
+	var SelfKickOut = 21;
+	/** 获取指定帧范围的帧事件 **/
+	var GetFrameAt = 22;
+	/** 设置房间状态数据 **/
+	var SetRoomState = 23;
+	/** 房间状态更新 **/
+	var RoomStateUpdate = 24;
+	/** 设置用户状态 **/
+	var SetClientState = 25;
+	/** 用户状态发生变化 **/
+	var ClientStateUpdate = 26;
+	/** 帧同步准备传输 **/
+	var FrameSyncReady = 27;
+	/** 重置房间状态 **/
+	var ResetRoom = 28;
+	/** 匹配成功，匹配成功后，可通过GetRoomData获取房间信息 **/
+	var Matched = 29;
+	/** 锁定房间 **/
+	var LockRoom = 30;
+	/** 取消锁定房间 **/
+	var UnlockRoom = 31;
+	/** 匹配房间 **/
+	var MatchRoom = 32;
+	/** 设置房间的匹配参数 **/
+	var SetRoomMatchOption = 33;
+	/** 更新房间用户中的数据 **/
+	var UpdateRoomUserData = 34;
+	/** 获取房间列表 **/
+	var GetRoomList = 35;
+	/** 发送全服消息 **/
+	var SendServerMsg = 36;
+	/** 接收到全服消息 **/
+	var EVENT_GetServerMsg = 37;
+	/** 侦听服务器通知（data中可指定op，默认为EVENT_GetServerMsg） **/
+	var ListenerServer = 38;
+	/** 取消侦听服务器通知（data中可指定op，默认为EVENT_GetServerMsg） **/
+	var CannelListenerServer = 39;
+	/** 通过UID获取用户数据 **/
+	var GetUserDataByUID = 40;
+	/** 获取全服历史消息 **/
+	var GetServerOldMsg = 41;
+	/** 调用扩展方法 **/
+	var ExtendsCall = 42;
+	/** 取消匹配用户 **/
+	var CannelMatchUser = 43;
+	/** 发送消息给用户 **/
+	var SendToUser = 44;
+	/** 接收到用户独立消息内容 **/
+	var UserMessage = 45;
+	/** 查询房间列表 **/
+	var QueryRoomList = 46;
+	/** 停止帧同步但不解锁房间（适用于游戏结束后，等待玩家结算的情况 **/
+	var StopFrameSyncWithoutUnlock = 47;
+	/** 更换座位 **/
+	var SwitchSeat = 48;
+	/** 座位更新通知 **/
+	var SeatUpdate = 49;
+	/** 房间列表变更通知 **/
+	var EVENT_RoomListChanged = 50;
+	/** 恢复会话（断线重连时使用，data: {session: 登录返回的会话Token, seq: 最后收到的消息序号}） **/
+	var ResumeSession = 51;
+	/** 合并下发的消息（登录时batch=true开启），data为多条完整消息组成的数组，需按顺序处理 **/
+	var Batch = 52;
+	/** 发送通道积压导致消息被丢弃的通知，data: {count: 本次通知丢弃的数量, total: 累计丢弃的数量} **/
+	var MessagesDropped = 53;
+	/** 上报指定帧的状态校验值（data: {t: 帧序号, checksum: 校验值}），用于检测客户端之间的状态不同步 **/
+	var ReportChecksum = 54;
+	/** 状态不同步通知，data: {t: 帧序号, uids: 校验值与多数玩家不一致的用户, checksums: uid -> 校验值} **/
+	var Desync = 55;
+	/** 追帧（data: {from: 开始帧}），断线重新加入帧同步中的房间时服务器会自动推送，data: {from: 开始帧, t: 当前帧} **/
+	var FrameCatchUp = 56;
+	/** 追帧分片，data: {from, to, t: 当前帧, last: 是否为最后一个分片, z: gzip压缩的帧数据JSON} **/
+	var CatchUpChunk = 57;
+	/** 播放回放（data: {id: 回放ID, speed: 播放速度, from: 跳转到的帧}），回复回放信息，之后按原始帧率下发FData **/
+	var PlayReplay = 58;
+	/** 控制回放（data: {action: pause/resume/seek/speed/stop, t: 跳转的帧, speed: 1/2/4}） **/
+	var ReplayControl = 59;
+	/** 回放状态变化通知，data: {id, state: playing/paused/reset/ended/stopped, t: 已播放的帧, total: 总帧数, speed} **/
+	var ReplayState = 60;
+	/** 时间同步（data: {c: 客户端时间}），回复 {c, wall: 服务器时间, mono: 服务器单调时间, t: 当前帧, interval: 帧间隔, rtt} **/
+	var TimeSync = 61;
+	/** 应用层心跳，data: {s: 服务器单调时间}，客户端收到后需回复Pong **/
+	var Ping = 62;
+	/** 心跳回复（data: {s: Ping中的s}），服务器据此计算往返延迟，不会回复 **/
+	var Pong = 63;
+	/** 修改帧同步中的帧率（房主操作，data: {fps, at: 生效的帧序号}），回复帧率变更 **/
+	var ChangeFrameRate = 64;
+	/** 帧率变更通知，data: {fps, at: 生效的帧序号, prev: 变更前的帧率} **/
+	var FrameRateChanged = 65;
+}
+
+enum abstract ClientErrorCode(Int) from Int to Int {
+	/** 创建房间信息错误 **/
+	var CREATE_ROOM_ERROR = 1001;
+	/** 获取房间信息错误 **/
+	var GET_ROOM_ERROR = 1002;
+	/** 启动帧同步错误 **/
+	var START_FRAME_SYNC_ERROR = 1003;
+	/** 停止帧同步错误 **/
+	var STOP_FRAME_SYNC_ERROR = 1004;
+	/** 上传帧同步数据错误 **/
+	var UPLOAD_FRAME_ERROR = 1005;
+	/** 登陆失败 **/
+	var LOGIN_ERROR = 1006;
+	/** 在别处登陆事件 **/
+	var LOGIN_OUT_ERROR = 1007;
+	/** 无效的操作指令 **/
+	var OP_ERROR = 1008;
+	/** 发送房间消息错误 **/
+	var SEND_ROOM_ERROR = 1009;
+	/** 加入房间错误 **/
+	var JOIN_ROOM_ERROR = 1010;
+	/** 退出房间错误 **/
+	var EXIT_ROOM_ERROR = 1011;
+	/** 匹配错误 **/
+	var MATCH_ERROR = 1012;
+	/** 更新用户数据错误 **/
+	var UPDATE_USER_ERROR = 1013;
+	/** 房间不存在 **/
+	var ROOM_NOT_EXSIT = 1014;
+	/** 房间权限不足 **/
+	var ROOM_PERMISSION_DENIED = 1015;
+	/** 数据结果错误 **/
+	var DATA_ERROR = 1016;
+	/** 会话恢复失败 **/
+	var SESSION_ERROR = 1017;
+	/** 观战延迟期间帧已被清除，无法下发 **/
+	var FRAMES_DISCARDED = 1018;
+}
+
+typedef ClientError = {
+	/** 错误码 **/
+	var code:Int;
+	/** 错误操作 **/
+	var op:Int;
+	/** 错误信息 **/
+	var msg:String;
+}
+
+/** 创建房间 **/
+typedef CreateRoomRequest = {
+	/** 帧同步帧率，0使用默认值30 **/
+	@:optional var fps:Float;
+	/** 帧同步模式，不传时为自由下发 **/
+	@:optional var frameSync:FrameSyncOption;
+	/** 是否录制帧同步回放（服务器需开启回放目录） **/
+	@:optional var record:Bool;
+	/** 观战人数上限，0表示不允许观战，不传时为10 **/
+	@:optional var maxSpectators:Int;
+	/** 观战延迟（毫秒），不传时为3000 **/
+	@:optional var spectatorDelay:Int;
+	/** 帧操作校验器（服务器注册的扩展名称），不传时不校验 **/
+	@:optional var validator:String;
+	/** 每个玩家每帧最多提交的操作数，0使用默认值8 **/
+	@:optional var maxInputs:Int;
+	/** 单个操作的最大字节数（按JSON编码后计算），0使用默认值1024 **/
+	@:optional var maxInputSize:Int;
+}
+
+/** 帧同步参数，创建房间与开启帧同步时可指定，未指定的字段沿用房间当前的配置 **/
+typedef FrameSyncOption = {
+	/** 帧同步模式：free、lockstep **/
+	@:optional var mode:String;
+	/** 锁步模式的输入延迟（帧），提交的操作最早在当前帧之后的第inputDelay+1帧生效 **/
+	@:optional var inputDelay:Int;
+	/** 锁步模式每帧等待玩家操作的超时时间（毫秒），0使用默认值500 **/
+	@:optional var timeout:Int;
+	/** 锁步模式超时未提交操作的处理策略：fill、stall、drop **/
+	@:optional var straggler:String;
+	/** 操作晚于期望的帧到达时的处理策略：shift、drop **/
+	@:optional var late:String;
+	/** 紧凑帧数据最多合并的连续空帧数，0使用默认值8 **/
+	@:optional var idleFold:Int;
+}
+
+/** 房间ID **/
+typedef RoomIdReply = {
+	/** 房间ID **/
+	var id:Int;
+}
+
+/** 加入房间 **/
+typedef JoinRoomRequest = {
+	/** 房间ID **/
+	var id:Int;
+	/** 房间密码 **/
+	@:optional var password:String;
+	/** 是否以观战身份加入（不受房间锁定与人数限制） **/
+	@:optional var spectate:Bool;
+}
+
+/** 房间信息 **/
+typedef RoomData = {
+	/** 房间ID **/
+	var id:Int;
+	/** 房主 **/
+	var master:UserData;
+	/** 房间内的用户 **/
+	var users:Array<UserData>;
+	/** 座位号 -> uid **/
+	var seats:haxe.DynamicAccess<Int>;
+	/** 最大人数 **/
+	var max:Int;
+	/** 观战用户 **/
+	var spectators:Array<UserData>;
+	/** 观战人数上限 **/
+	var maxSpectators:Int;
+	/** 当前帧率 **/
+	var fps:Float;
+	/** 本局帧同步已公布的帧率变更 **/
+	var rateChanges:Array<FrameRateEvent>;
+	/** uid -> 本局帧同步的操作到达统计 **/
+	var inputStats:haxe.DynamicAccess<InputStats>;
+	/** 帧操作校验器，为空时不校验 **/
+	var validator:String;
+	/** 每个玩家每帧最多提交的操作数 **/
+	var maxInputs:Int;
+	/** 单个操作的最大字节数 **/
+	var maxInputSize:Int;
+	/** 房间自定义数据 **/
+	var data:haxe.DynamicAccess<Dynamic>;
+	/** 房间状态 **/
+	var state:haxe.DynamicAccess<Dynamic>;
+	/** uid -> 用户状态 **/
+	var usersState:haxe.DynamicAccess<haxe.DynamicAccess<Dynamic>>;
+}
+
+/** 房间内的用户数据 **/
+typedef UserData = {
+	/** 用户ID **/
+	var uid:Int;
+	/** 用户名称 **/
+	var name:String;
+	/** 座位号（0=未分配） **/
+	var seat:Int;
+	/** 是否为观战用户 **/
+	var spectator:Bool;
+	/** 用户自定义数据 **/
+	var data:haxe.DynamicAccess<Dynamic>;
+	/** 连接累计丢弃的消息数量 **/
+	var dropped:Int;
+	/** 往返延迟（毫秒），0表示尚未测量 **/
+	var rtt:Int;
+}
+
+/** 帧率变更 **/
+typedef FrameRateEvent = {
+	/** 新的帧率 **/
+	var fps:Float;
+	/** 生效的帧序号：第at-1帧与第at帧的间隔起按新的帧率 **/
+	var at:Int;
+	/** 变更前的帧率 **/
+	var prev:Float;
+}
+
+/** 玩家本局帧同步的操作到达统计 **/
+typedef InputStats = {
+	/** 提交的操作数 **/
+	var inputs:Int;
+	/** 指定了期望帧的操作数 **/
+	var targeted:Int;
+	/** 指定了期望帧的操作，期望帧相对尚未下发的第一帧的平均提前帧数（迟到为负数） **/
+	var avgLead:Float;
+	/** 晚于期望帧到达的操作数 **/
+	var late:Int;
+	/** 因迟到被丢弃的操作数 **/
+	var dropped:Int;
+	/** 最大迟到帧数 **/
+	var maxLate:Int;
+	/** 迟到操作的平均迟到帧数 **/
+	var avgLate:Float;
+	/** 已随帧下发的操作数 **/
+	var delivered:Int;
+	/** 操作从服务器收到到随帧下发的平均等待时间（毫秒） **/
+	var avgWait:Float;
+	/** 最大等待时间（毫秒） **/
+	var maxWait:Int;
+}
+
+/** 上传帧同步数据的回复 **/
+typedef UploadFrameReply = {
+	/** 该操作生效的帧序号 **/
+	@:optional var t:Int;
+}
+
+/** 登陆 **/
+typedef LoginRequest = {
+	/** 用户唯一标识 **/
+	var openid:String;
+	/** 用户名称 **/
+	var username:String;
+	/** 应用ID，不同应用的用户互不影响 **/
+	var appid:String;
+	/** 是否开启消息合并下发（开启后可能收到Batch消息） **/
+	@:optional var batch:Bool;
+	/** 是否开启紧凑帧数据（合并连续的空帧、省略重复的操作） **/
+	@:optional var compact:Bool;
+	/** 上传帧数据时不回复确认，只在出错时回复错误 **/
+	@:optional var noAck:Bool;
+	/** 客户端支持的协议版本，不传时为1 **/
+	@:optional var version:Int;
+}
+
+/** 登陆回复 **/
+typedef LoginReply = {
+	/** 用户ID **/
+	var uid:Int;
+	/** 会话Token，用于断线后恢复会话（未开启时不返回） **/
+	@:optional var session:String;
+	/** 协商的协议版本 **/
+	var version:Int;
+	/** 当前协议版本可调用的op **/
+	var ops:Array<Int>;
+	/** 已开启的可选功能 **/
+	var features:Array<String>;
+	/** 服务器的限制参数 **/
+	var limits:ProtocolLimits;
+}
+
+/** 服务器的限制参数 **/
+typedef ProtocolLimits = {
+	/** 单条消息的最大字节数 **/
+	var maxMessageSize:Int;
+	/** 最低帧率 **/
+	var minFps:Float;
+	/** 最高帧率 **/
+	var maxFps:Float;
+	/** 默认帧率 **/
+	var defaultFps:Float;
+	/** 房间人数上限 **/
+	var maxRoomCounts:Int;
+	/** 观战人数上限 **/
+	var maxSpectators:Int;
+	/** GetFrameAt每次最多获取的帧数 **/
+	var maxFramePage:Int;
+}
+
+/** 帧数据 **/
+typedef FrameEvent = {
+	/** 帧序号 **/
+	var t:Int;
+	/** uid -> 该帧的操作列表 **/
+	var d:haxe.DynamicAccess<Array<Dynamic>>;
+	/** 紧凑模式：本条消息包含第t-n+1~t帧，其中t之前的帧均为空帧，不传时为1 **/
+	@:optional var n:Int;
+	/** 紧凑模式：操作与上一帧（第t-1帧）相同的uid **/
+	@:optional var r:Array<Int>;
+}
+
+/** 携带发送者uid的数据 **/
+typedef UserPayload = {
+	/** 发送者用户ID **/
+	var uid:Int;
+	/** 数据内容 **/
+	var data:Dynamic;
+}
+
+/** 房间、玩家之间匹配可选参数，匹配参数会跟用户的data参数进行匹配 **/
+typedef MatchOption = {
+	/** 匹配key，字符串比较，当为一样的时候，则对匹配，如果为空字符串时，则忽略此匹配 **/
+	@:optional var key:String;
+	/** 匹配所需的总人数 **/
+	var number:Int;
+	/** 匹配参数的最小值，到最大值 **/
+	@:optional var range:haxe.DynamicAccess<MatchRange>;
+	/** 帧同步帧率，0使用默认值30。匹配时不同FPS不会配对在一起 **/
+	@:optional var fps:Float;
+}
+
+/** 匹配的范围值 **/
+typedef MatchRange = {
+	/** 最小值 **/
+	var min:Int;
+	/** 最大值 **/
+	var max:Int;
+}
+
+/** 房间历史消息 **/
+typedef RoomOldMessageReply = {
+	/** 历史消息 **/
+	var list:Array<ClientMessage>;
+}
+
+typedef ClientMessage = {
+	/** 客户端行为 **/
+	var op:Int;
+	/** 客户端数据 **/
+	var data:Dynamic;
+	/** 请求ID（可选），服务器会在该请求的直接回复与错误中原样返回 **/
+	@:optional var id:Int;
+	/** 是否为服务器主动推送的事件（如FData、RoomStateUpdate），直接回复不会带有该标记 **/
+	@:optional var push:Bool;
+	/** 下发消息的序号（开启可恢复会话后，每条下发消息按顺序递增编号） **/
+	@:optional var seq:Int;
+	/** UploadFrame：客户端期望该操作生效的帧序号（可选） **/
+	@:optional var tick:Int;
+}
+
+/** 更新房间配置 **/
+typedef UpdateRoomOptionRequest = {
+	/** 最大人数 **/
+	@:optional var maxCounts:Int;
+	/** 房间密码，为空时取消密码 **/
+	@:optional var password:String;
+	/** 观战人数上限，不传时不修改 **/
+	@:optional var maxSpectators:Int;
+	/** 观战延迟（毫秒），不传时不修改 **/
+	@:optional var spectatorDelay:Int;
+}
+
+/** 指定用户 **/
+typedef UidRequest = {
+	/** 用户ID **/
+	var uid:Int;
+}
+
+/** 获取指定帧范围的帧事件 **/
+typedef GetFrameAtRequest = {
+	/** 开始位置（第start+1帧） **/
+	@:optional var start:Int;
+	/** 结束位置（不包含），0表示获取一页（最多maxFramePage帧） **/
+	@:optional var end:Int;
+}
+
+/** 获取房间列表 **/
+typedef GetRoomListRequest = {
+	/** 页码，从0开始 **/
+	@:optional var page:Int;
+	/** 每页数量 **/
+	@:optional var counts:Int;
+}
+
+/** 房间列表 **/
+typedef RoomListReply = {
+	/** 在线人数 **/
+	var onlineCounts:Int;
+	/** 房间列表 **/
+	var list:Array<RoomInfo>;
+}
+
+/** 房间的基础信息 **/
+typedef RoomInfo = {
+	/** 房间id **/
+	var id:Int;
+	/** 当前人数 **/
+	var counts:Int;
+	/** 最大人数 **/
+	var maxCounts:Int;
+	/** 当前观战人数 **/
+	var spectators:Int;
+	/** 观战人数上限 **/
+	var maxSpectators:Int;
+	/** 是否存在密码 **/
+	var password:Bool;
+	/** 房主名称 **/
+	var master:String;
+	/** 房间是否已锁定 **/
+	var lock:Bool;
+	/** 对应customData数据 **/
+	var data:Dynamic;
+}
+
+/** 侦听服务器通知 **/
+typedef ListenerRequest = {
+	/** 侦听的op，0表示EVENT_GetServerMsg **/
+	@:optional var op:Int;
+}
+
+/** 通过UID获取的用户数据 **/
+typedef UserDataByUidReply = {
+	/** 用户ID **/
+	var uid:Int;
+	/** 用户名称 **/
+	var name:String;
+	/** 用户自定义数据 **/
+	var data:Dynamic;
+}
+
+/** 获取全服历史消息 **/
+typedef GetServerOldMsgRequest = {
+	/** 获取的消息数量 **/
+	var counts:Int;
+}
+
+/** 调用扩展方法 **/
+typedef ExtendsCallRequest = {
+	/** 扩展方法名称（类型名.方法名） **/
+	var f:String;
+	/** 扩展方法参数 **/
+	@:optional var d:Dynamic;
+}
+
+/** 发送消息给用户 **/
+typedef SendToUserRequest = {
+	/** 目标用户ID **/
+	var uid:Int;
+	/** 消息内容 **/
+	var data:Dynamic;
+}
+
+/** 查询房间列表 **/
+typedef QueryRoomListRequest = {
+	/** 房间ID列表 **/
+	var roomids:Array<Int>;
+}
+
+/** 查询房间列表 **/
+typedef QueryRoomListReply = {
+	/** 房间列表 **/
+	var list:Array<RoomInfo>;
+}
+
+/** 更换座位 **/
+typedef SwitchSeatRequest = {
+	/** 目标座位号（1~房间最大人数） **/
+	var seat:Int;
+}
+
+/** 座位更新 **/
+typedef SeatUpdateEvent = {
+	/** 用户ID **/
+	var uid:Int;
+	/** 原座位号 **/
+	var oldSeat:Int;
+	/** 新座位号 **/
+	var newSeat:Int;
+}
+
+/** 房间列表变更 **/
+typedef RoomListChangedEvent = {
+	/** 变更类型 **/
+	var type:String;
+}
+
+/** 恢复会话 **/
+typedef ResumeSessionRequest = {
+	/** 应用ID，需要与登录时的应用一致 **/
+	var appid:String;
+	/** 登录时返回的会话Token **/
+	var session:String;
+	/** 连续收到的最大消息序号（该序号及之前的消息均已收到） **/
+	var seq:Int;
+}
+
+/** 消息丢弃通知 **/
+typedef MessagesDroppedEvent = {
+	/** 本次通知丢弃的消息数量 **/
+	var count:Int;
+	/** 连接累计丢弃的消息数量 **/
+	var total:Int;
+}
+
+/** 上报帧校验值 **/
+typedef ReportChecksumRequest = {
+	/** 帧序号（与FData的t一致） **/
+	var t:Int;
+	/** 该帧的状态校验值 **/
+	var checksum:String;
+}
+
+/** 状态不同步通知 **/
+typedef DesyncEvent = {
+	/** 帧序号 **/
+	var t:Int;
+	/** 校验值与多数玩家不一致的用户（数量相同时为所有用户） **/
+	var uids:Array<Int>;
+	/** uid -> 上报的校验值 **/
+	var checksums:haxe.DynamicAccess<String>;
+}
+
+/** 追帧 **/
+typedef FrameCatchUpRequest = {
+	/** 开始帧，0表示从第1帧开始 **/
+	@:optional var from:Int;
+}
+
+/** 追帧信息 **/
+typedef CatchUpInfo = {
+	/** 开始帧 **/
+	var from:Int;
+	/** 开始追帧时的当前帧 **/
+	var t:Int;
+}
+
+/** 追帧分片 **/
+typedef CatchUpChunkEvent = {
+	/** 分片的开始帧 **/
+	var from:Int;
+	/** 分片的结束帧（包含） **/
+	var to:Int;
+	/** 房间的当前帧 **/
+	var t:Int;
+	/** 是否为最后一个分片，之后的帧通过FData下发 **/
+	var last:Bool;
+	/** gzip压缩的帧数据JSON：[{t: 帧序号, d: uid -> 操作列表}]（JSON编码时为base64） **/
+	var z:String;
+}
+
+/** 播放回放 **/
+typedef PlayReplayRequest = {
+	/** 回放ID **/
+	var id:String;
+	/** 播放速度，默认为1 **/
+	@:optional var speed:Int;
+	/** 快进到的帧，0表示从头播放 **/
+	@:optional var from:Int;
+}
+
+/** 回放信息 **/
+typedef ReplayMeta = {
+	/** 回放ID **/
+	var id:String;
+	/** 房间ID **/
+	var roomId:Int;
+	/** 帧率 **/
+	var fps:Float;
+	/** 帧同步模式 **/
+	var frameSync:FrameSyncOption;
+	/** 开始帧同步的时间（Unix毫秒） **/
+	var startTime:Int;
+	/** 停止帧同步的时间（Unix毫秒） **/
+	var endTime:Int;
+	/** 房主uid **/
+	var master:Int;
+	/** 房间内的用户 **/
+	var users:Array<ReplayUser>;
+	/** 座位号 -> uid **/
+	var seats:haxe.DynamicAccess<Int>;
+	/** 房间自定义数据 **/
+	var customData:haxe.DynamicAccess<Dynamic>;
+	/** 总帧数 **/
+	var frames:Int;
+	/** 帧率变更（fps为开始时的帧率） **/
+	@:optional var rateChanges:Array<FrameRateEvent>;
+}
+
+/** 回放中的用户 **/
+typedef ReplayUser = {
+	/** 用户ID **/
+	var uid:Int;
+	/** 用户名称 **/
+	var name:String;
+	/** 座位号 **/
+	var seat:Int;
+	/** 用户自定义数据 **/
+	var data:haxe.DynamicAccess<Dynamic>;
+}
+
+/** 控制回放 **/
+typedef ReplayControlRequest = {
+	/** 控制操作 **/
+	var action:String;
+	/** seek跳转到的帧（跳转后已播放到该帧） **/
+	@:optional var t:Int;
+	/** speed修改的播放速度 **/
+	@:optional var speed:Int;
+}
+
+/** 回放状态 **/
+typedef ReplayStateEvent = {
+	/** 回放ID **/
+	var id:String;
+	/** 状态：playing、paused、reset（向前跳转，需重置到第0帧）、ended、stopped **/
+	var state:String;
+	/** 已播放的帧 **/
+	var t:Int;
+	/** 总帧数 **/
+	var total:Int;
+	/** 播放速度 **/
+	var speed:Int;
+}
+
+/** 时间同步 **/
+typedef TimeSyncRequest = {
+	/** 客户端发送时的时间，原样返回 **/
+	@:optional var c:Int;
+}
+
+/** 时间同步的回复 **/
+typedef TimeSyncReply = {
+	/** 请求中的客户端时间 **/
+	var c:Int;
+	/** 服务器时间（Unix毫秒） **/
+	var wall:Int;
+	/** 服务器单调时间（服务器启动后的毫秒数） **/
+	var mono:Int;
+	/** 房间当前帧，不在帧同步中时为0 **/
+	var t:Int;
+	/** 帧间隔（毫秒），不在帧同步中时为0 **/
+	var interval:Float;
+	/** 服务器测量的往返延迟（毫秒），0表示尚未测量 **/
+	var rtt:Int;
+}
+
+/** 应用层心跳 **/
+typedef PingEvent = {
+	/** 服务器单调时间，Pong时原样返回 **/
+	var s:Int;
+}
+
+/** 心跳回复 **/
+typedef PongRequest = {
+	/** Ping中的服务器单调时间 **/
+	var s:Int;
+}
+
+/** 修改帧率 **/
+typedef ChangeFrameRateRequest = {
+	/** 新的帧率 **/
+	var fps:Float;
+	/** 生效的帧序号，不传时为下一帧之后的一帧 **/
+	@:optional var at:Int;
+}
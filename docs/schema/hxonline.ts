@@ -0,0 +1,852 @@
+// 由 `websocket_server schema ts` 生成，请勿手动修改
+
+export const PROTOCOL_VERSION = 7;
+
+export enum ClientAction {
+  /** 通用错误，发生错误时，Data请传递`ClientError`结构体 */
+  Error = -1,
+  /** 普通消息 */
+  Message = 0,
+  /** 创建房间 */
+  CreateRoom = 1,
+  /** 加入房间 */
+  JoinRoom = 2,
+  /** 房间信息变更 */
+  ChangedRoom = 3,
+  /** 获取房间信息 */
+  GetRoomData = 4,
+  /** 开启帧同步 */
+  StartFrameSync = 5,
+  /** 停止帧同步 */
+  StopFrameSync = 6,
+  /** 上传帧同步数据 */
+  UploadFrame = 7,
+  /** 登陆用户 */
+  Login = 8,
+  /** 帧数据 */
+  FData = 9,
+  /** 发送房间消息 */
+  RoomMessage = 10,
+  /** 加入房间的客户端信息 */
+  JoinRoomClient = 11,
+  /** 退出房间的客户端信息 */
+  ExitRoomClient = 12,
+  /** 在房间中离线的客户端信息，请注意，只有开启了帧同步的情况下收到 */
+  OutOnlineRoomClient = 13,
+  /** 退出房间 */
+  ExitRoom = 14,
+  /** 匹配用户 */
+  MatchUser = 15,
+  /** 更新用户数据 */
+  UpdateUserData = 16,
+  /** 获取房间的历史消息 */
+  GetRoomOldMessage = 17,
+  /** 更新自定义房间信息（房主操作） */
+  UpdateRoomCustomData = 18,
+  /** 更新房间的配置，如人数、密码等（房主操作） */
+  UpdateRoomOption = 19,
+  /** 踢出用户（房主操作） */
+  KickOut = 20,
+  /** 自已被踢出房间 */
+  SelfKickOut = 21,
+  /** 获取指定帧范围的帧事件 */
+  GetFrameAt = 22,
+  /** 设置房间状态数据 */
+  SetRoomState = 23,
+  /** 房间状态更新 */
+  RoomStateUpdate = 24,
+  /** 设置用户状态 */
+  SetClientState = 25,
+  /** 用户状态发生变化 */
+  ClientStateUpdate = 26,
+  /** 帧同步准备传输 */
+  FrameSyncReady = 27,
+  /** 重置房间状态 */
+  ResetRoom = 28,
+  /** 匹配成功，匹配成功后，可通过GetRoomData获取房间信息 */
+  Matched = 29,
+  /** 锁定房间 */
+  LockRoom = 30,
+  /** 取消锁定房间 */
+  UnlockRoom = 31,
+  /** 匹配房间 */
+  MatchRoom = 32,
+  /** 设置房间的匹配参数 */
+  SetRoomMatchOption = 33,
+  /** 更新房间用户中的数据 */
+  UpdateRoomUserData = 34,
+  /** 获取房间列表 */
+  GetRoomList = 35,
+  /** 发送全服消息 */
+  SendServerMsg = 36,
+  /** 接收到全服消息 */
+  EVENT_GetServerMsg = 37,
+  /** 侦听服务器通知（data中可指定op，默认为EVENT_GetServerMsg） */
+  ListenerServer = 38,
+  /** 取消侦听服务器通知（data中可指定op，默认为EVENT_GetServerMsg） */
+  CannelListenerServer = 39,
+  /** 通过UID获取用户数据 */
+  GetUserDataByUID = 40,
+  /** 获取全服历史消息 */
+  GetServerOldMsg = 41,
+  /** 调用扩展方法 */
+  ExtendsCall = 42,
+  /** 取消匹配用户 */
+  CannelMatchUser = 43,
+  /** 发送消息给用户 */
+  SendToUser = 44,
+  /** 接收到用户独立消息内容 */
+  UserMessage = 45,
+  /** 查询房间列表 */
+  QueryRoomList = 46,
+  /** 停止帧同步但不解锁房间（适用于游戏结束后，等待玩家结算的情况 */
+  StopFrameSyncWithoutUnlock = 47,
+  /** 更换座位 */
+  SwitchSeat = 48,
+  /** 座位更新通知 */
+  SeatUpdate = 49,
+  /** 房间列表变更通知 */
+  EVENT_RoomListChanged = 50,
+  /** 恢复会话（断线重连时使用，data: {session: 登录返回的会话Token, seq: 最后收到的消息序号}） */
+  ResumeSession = 51,
+  /** 合并下发的消息（登录时batch=true开启），data为多条完整消息组成的数组，需按顺序处理 */
+  Batch = 52,
+  /** 发送通道积压导致消息被丢弃的通知，data: {count: 本次通知丢弃的数量, total: 累计丢弃的数量} */
+  MessagesDropped = 53,
+  /** 上报指定帧的状态校验值（data: {t: 帧序号, checksum: 校验值}），用于检测客户端之间的状态不同步 */
+  ReportChecksum = 54,
+  /** 状态不同步通知，data: {t: 帧序号, uids: 校验值与多数玩家不一致的用户, checksums: uid -> 校验值} */
+  Desync = 55,
+  /** 追帧（data: {from: 开始帧}），断线重新加入帧同步中的房间时服务器会自动推送，data: {from: 开始帧, t: 当前帧} */
+  FrameCatchUp = 56,
+  /** 追帧分片，data: {from, to, t: 当前帧, last: 是否为最后一个分片, z: gzip压缩的帧数据JSON} */
+  CatchUpChunk = 57,
+  /** 播放回放（data: {id: 回放ID, speed: 播放速度, from: 跳转到的帧}），回复回放信息，之后按原始帧率下发FData */
+  PlayReplay = 58,
+  /** 控制回放（data: {action: pause/resume/seek/speed/stop, t: 跳转的帧, speed: 1/2/4}） */
+  ReplayControl = 59,
+  /** 回放状态变化通知，data: {id, state: playing/paused/reset/ended/stopped, t: 已播放的帧, total: 总帧数, speed} */
+  ReplayState = 60,
+  /** 时间同步（data: {c: 客户端时间}），回复 {c, wall: 服务器时间, mono: 服务器单调时间, t: 当前帧, interval: 帧间隔, rtt} */
+  TimeSync = 61,
+  /** 应用层心跳，data: {s: 服务器单调时间}，客户端收到后需回复Pong */
+  Ping = 62,
+  /** 心跳回复（data: {s: Ping中的s}），服务器据此计算往返延迟，不会回复 */
+  Pong = 63,
+  /** 修改帧同步中的帧率（房主操作，data: {fps, at: 生效的帧序号}），回复帧率变更 */
+  ChangeFrameRate = 64,
+  /** 帧率变更通知，data: {fps, at: 生效的帧序号, prev: 变更前的帧率} */
+  FrameRateChanged = 65,
+}
+
+export enum ClientErrorCode {
+  /** 创建房间信息错误 */
+  CREATE_ROOM_ERROR = 1001,
+  /** 获取房间信息错误 */
+  GET_ROOM_ERROR = 1002,
+  /** 启动帧同步错误 */
+  START_FRAME_SYNC_ERROR = 1003,
+  /** 停止帧同步错误 */
+  STOP_FRAME_SYNC_ERROR = 1004,
+  /** 上传帧同步数据错误 */
+  UPLOAD_FRAME_ERROR = 1005,
+  /** 登陆失败 */
+  LOGIN_ERROR = 1006,
+  /** 在别处登陆事件 */
+  LOGIN_OUT_ERROR = 1007,
+  /** 无效的操作指令 */
+  OP_ERROR = 1008,
+  /** 发送房间消息错误 */
+  SEND_ROOM_ERROR = 1009,
+  /** 加入房间错误 */
+  JOIN_ROOM_ERROR = 1010,
+  /** 退出房间错误 */
+  EXIT_ROOM_ERROR = 1011,
+  /** 匹配错误 */
+  MATCH_ERROR = 1012,
+  /** 更新用户数据错误 */
+  UPDATE_USER_ERROR = 1013,
+  /** 房间不存在 */
+  ROOM_NOT_EXSIT = 1014,
+  /** 房间权限不足 */
+  ROOM_PERMISSION_DENIED = 1015,
+  /** 数据结果错误 */
+  DATA_ERROR = 1016,
+  /** 会话恢复失败 */
+  SESSION_ERROR = 1017,
+  /** 观战延迟期间帧已被清除，无法下发 */
+  FRAMES_DISCARDED = 1018,
+}
+
+export interface ClientError {
+  /** 错误码 */
+  code: number;
+  /** 错误操作 */
+  op: number;
+  /** 错误信息 */
+  msg: string;
+}
+
+/** 创建房间 */
+export interface CreateRoomRequest {
+  /** 帧同步帧率，0使用默认值30 */
+  fps?: number;
+  /** 帧同步模式，不传时为自由下发 */
+  frameSync?: FrameSyncOption;
+  /** 是否录制帧同步回放（服务器需开启回放目录） */
+  record?: boolean;
+  /** 观战人数上限，0表示不允许观战，不传时为10 */
+  maxSpectators?: number;
+  /** 观战延迟（毫秒），不传时为3000 */
+  spectatorDelay?: number;
+  /** 帧操作校验器（服务器注册的扩展名称），不传时不校验 */
+  validator?: string;
+  /** 每个玩家每帧最多提交的操作数，0使用默认值8 */
+  maxInputs?: number;
+  /** 单个操作的最大字节数（按JSON编码后计算），0使用默认值1024 */
+  maxInputSize?: number;
+}
+
+/** 帧同步参数，创建房间与开启帧同步时可指定，未指定的字段沿用房间当前的配置 */
+export interface FrameSyncOption {
+  /** 帧同步模式：free、lockstep */
+  mode?: string;
+  /** 锁步模式的输入延迟（帧），提交的操作最早在当前帧之后的第inputDelay+1帧生效 */
+  inputDelay?: number;
+  /** 锁步模式每帧等待玩家操作的超时时间（毫秒），0使用默认值500 */
+  timeout?: number;
+  /** 锁步模式超时未提交操作的处理策略：fill、stall、drop */
+  straggler?: string;
+  /** 操作晚于期望的帧到达时的处理策略：shift、drop */
+  late?: string;
+  /** 紧凑帧数据最多合并的连续空帧数，0使用默认值8 */
+  idleFold?: number;
+}
+
+/** 房间ID */
+export interface RoomIdReply {
+  /** 房间ID */
+  id: number;
+}
+
+/** 加入房间 */
+export interface JoinRoomRequest {
+  /** 房间ID */
+  id: number;
+  /** 房间密码 */
+  password?: string;
+  /** 是否以观战身份加入（不受房间锁定与人数限制） */
+  spectate?: boolean;
+}
+
+/** 房间信息 */
+export interface RoomData {
+  /** 房间ID */
+  id: number;
+  /** 房主 */
+  master: UserData;
+  /** 房间内的用户 */
+  users: Array<UserData>;
+  /** 座位号 -> uid */
+  seats: Record<number, number>;
+  /** 最大人数 */
+  max: number;
+  /** 观战用户 */
+  spectators: Array<UserData>;
+  /** 观战人数上限 */
+  maxSpectators: number;
+  /** 当前帧率 */
+  fps: number;
+  /** 本局帧同步已公布的帧率变更 */
+  rateChanges: Array<FrameRateEvent>;
+  /** uid -> 本局帧同步的操作到达统计 */
+  inputStats: Record<number, InputStats>;
+  /** 帧操作校验器，为空时不校验 */
+  validator: string;
+  /** 每个玩家每帧最多提交的操作数 */
+  maxInputs: number;
+  /** 单个操作的最大字节数 */
+  maxInputSize: number;
+  /** 房间自定义数据 */
+  data: Record<string, any>;
+  /** 房间状态 */
+  state: Record<string, any>;
+  /** uid -> 用户状态 */
+  usersState: Record<number, Record<string, any>>;
+}
+
+/** 房间内的用户数据 */
+export interface UserData {
+  /** 用户ID */
+  uid: number;
+  /** 用户名称 */
+  name: string;
+  /** 座位号（0=未分配） */
+  seat: number;
+  /** 是否为观战用户 */
+  spectator: boolean;
+  /** 用户自定义数据 */
+  data: Record<string, any>;
+  /** 连接累计丢弃的消息数量 */
+  dropped: number;
+  /** 往返延迟（毫秒），0表示尚未测量 */
+  rtt: number;
+}
+
+/** 帧率变更 */
+export interface FrameRateEvent {
+  /** 新的帧率 */
+  fps: number;
+  /** 生效的帧序号：第at-1帧与第at帧的间隔起按新的帧率 */
+  at: number;
+  /** 变更前的帧率 */
+  prev: number;
+}
+
+/** 玩家本局帧同步的操作到达统计 */
+export interface InputStats {
+  /** 提交的操作数 */
+  inputs: number;
+  /** 指定了期望帧的操作数 */
+  targeted: number;
+  /** 指定了期望帧的操作，期望帧相对尚未下发的第一帧的平均提前帧数（迟到为负数） */
+  avgLead: number;
+  /** 晚于期望帧到达的操作数 */
+  late: number;
+  /** 因迟到被丢弃的操作数 */
+  dropped: number;
+  /** 最大迟到帧数 */
+  maxLate: number;
+  /** 迟到操作的平均迟到帧数 */
+  avgLate: number;
+  /** 已随帧下发的操作数 */
+  delivered: number;
+  /** 操作从服务器收到到随帧下发的平均等待时间（毫秒） */
+  avgWait: number;
+  /** 最大等待时间（毫秒） */
+  maxWait: number;
+}
+
+/** 上传帧同步数据的回复 */
+export interface UploadFrameReply {
+  /** 该操作生效的帧序号 */
+  t?: number;
+}
+
+/** 登陆 */
+export interface LoginRequest {
+  /** 用户唯一标识 */
+  openid: string;
+  /** 用户名称 */
+  username: string;
+  /** 应用ID，不同应用的用户互不影响 */
+  appid: string;
+  /** 是否开启消息合并下发（开启后可能收到Batch消息） */
+  batch?: boolean;
+  /** 是否开启紧凑帧数据（合并连续的空帧、省略重复的操作） */
+  compact?: boolean;
+  /** 上传帧数据时不回复确认，只在出错时回复错误 */
+  noAck?: boolean;
+  /** 客户端支持的协议版本，不传时为1 */
+  version?: number;
+}
+
+/** 登陆回复 */
+export interface LoginReply {
+  /** 用户ID */
+  uid: number;
+  /** 会话Token，用于断线后恢复会话（未开启时不返回） */
+  session?: string;
+  /** 协商的协议版本 */
+  version: number;
+  /** 当前协议版本可调用的op */
+  ops: Array<number>;
+  /** 已开启的可选功能 */
+  features: Array<string>;
+  /** 服务器的限制参数 */
+  limits: ProtocolLimits;
+}
+
+/** 服务器的限制参数 */
+export interface ProtocolLimits {
+  /** 单条消息的最大字节数 */
+  maxMessageSize: number;
+  /** 最低帧率 */
+  minFps: number;
+  /** 最高帧率 */
+  maxFps: number;
+  /** 默认帧率 */
+  defaultFps: number;
+  /** 房间人数上限 */
+  maxRoomCounts: number;
+  /** 观战人数上限 */
+  maxSpectators: number;
+  /** GetFrameAt每次最多获取的帧数 */
+  maxFramePage: number;
+}
+
+/** 帧数据 */
+export interface FrameEvent {
+  /** 帧序号 */
+  t: number;
+  /** uid -> 该帧的操作列表 */
+  d: Record<number, Array<any>>;
+  /** 紧凑模式：本条消息包含第t-n+1~t帧，其中t之前的帧均为空帧，不传时为1 */
+  n?: number;
+  /** 紧凑模式：操作与上一帧（第t-1帧）相同的uid */
+  r?: Array<number>;
+}
+
+/** 携带发送者uid的数据 */
+export interface UserPayload {
+  /** 发送者用户ID */
+  uid: number;
+  /** 数据内容 */
+  data: any;
+}
+
+/** 房间、玩家之间匹配可选参数，匹配参数会跟用户的data参数进行匹配 */
+export interface MatchOption {
+  /** 匹配key，字符串比较，当为一样的时候，则对匹配，如果为空字符串时，则忽略此匹配 */
+  key?: string;
+  /** 匹配所需的总人数 */
+  number: number;
+  /** 匹配参数的最小值，到最大值 */
+  range?: Record<string, MatchRange>;
+  /** 帧同步帧率，0使用默认值30。匹配时不同FPS不会配对在一起 */
+  fps?: number;
+}
+
+/** 匹配的范围值 */
+export interface MatchRange {
+  /** 最小值 */
+  min: number;
+  /** 最大值 */
+  max: number;
+}
+
+/** 房间历史消息 */
+export interface RoomOldMessageReply {
+  /** 历史消息 */
+  list: Array<ClientMessage>;
+}
+
+export interface ClientMessage {
+  /** 客户端行为 */
+  op: number;
+  /** 客户端数据 */
+  data: any;
+  /** 请求ID（可选），服务器会在该请求的直接回复与错误中原样返回 */
+  id?: number;
+  /** 是否为服务器主动推送的事件（如FData、RoomStateUpdate），直接回复不会带有该标记 */
+  push?: boolean;
+  /** 下发消息的序号（开启可恢复会话后，每条下发消息按顺序递增编号） */
+  seq?: number;
+  /** UploadFrame：客户端期望该操作生效的帧序号（可选） */
+  tick?: number;
+}
+
+/** 更新房间配置 */
+export interface UpdateRoomOptionRequest {
+  /** 最大人数 */
+  maxCounts?: number;
+  /** 房间密码，为空时取消密码 */
+  password?: string;
+  /** 观战人数上限，不传时不修改 */
+  maxSpectators?: number;
+  /** 观战延迟（毫秒），不传时不修改 */
+  spectatorDelay?: number;
+}
+
+/** 指定用户 */
+export interface UidRequest {
+  /** 用户ID */
+  uid: number;
+}
+
+/** 获取指定帧范围的帧事件 */
+export interface GetFrameAtRequest {
+  /** 开始位置（第start+1帧） */
+  start?: number;
+  /** 结束位置（不包含），0表示获取一页（最多maxFramePage帧） */
+  end?: number;
+}
+
+/** 获取房间列表 */
+export interface GetRoomListRequest {
+  /** 页码，从0开始 */
+  page?: number;
+  /** 每页数量 */
+  counts?: number;
+}
+
+/** 房间列表 */
+export interface RoomListReply {
+  /** 在线人数 */
+  onlineCounts: number;
+  /** 房间列表 */
+  list: Array<RoomInfo>;
+}
+
+/** 房间的基础信息 */
+export interface RoomInfo {
+  /** 房间id */
+  id: number;
+  /** 当前人数 */
+  counts: number;
+  /** 最大人数 */
+  maxCounts: number;
+  /** 当前观战人数 */
+  spectators: number;
+  /** 观战人数上限 */
+  maxSpectators: number;
+  /** 是否存在密码 */
+  password: boolean;
+  /** 房主名称 */
+  master: string;
+  /** 房间是否已锁定 */
+  lock: boolean;
+  /** 对应customData数据 */
+  data: any;
+}
+
+/** 侦听服务器通知 */
+export interface ListenerRequest {
+  /** 侦听的op，0表示EVENT_GetServerMsg */
+  op?: number;
+}
+
+/** 通过UID获取的用户数据 */
+export interface UserDataByUidReply {
+  /** 用户ID */
+  uid: number;
+  /** 用户名称 */
+  name: string;
+  /** 用户自定义数据 */
+  data: any;
+}
+
+/** 获取全服历史消息 */
+export interface GetServerOldMsgRequest {
+  /** 获取的消息数量 */
+  counts: number;
+}
+
+/** 调用扩展方法 */
+export interface ExtendsCallRequest {
+  /** 扩展方法名称（类型名.方法名） */
+  f: string;
+  /** 扩展方法参数 */
+  d?: any;
+}
+
+/** 发送消息给用户 */
+export interface SendToUserRequest {
+  /** 目标用户ID */
+  uid: number;
+  /** 消息内容 */
+  data: any;
+}
+
+/** 查询房间列表 */
+export interface QueryRoomListRequest {
+  /** 房间ID列表 */
+  roomids: Array<number>;
+}
+
+/** 查询房间列表 */
+export interface QueryRoomListReply {
+  /** 房间列表 */
+  list: Array<RoomInfo>;
+}
+
+/** 更换座位 */
+export interface SwitchSeatRequest {
+  /** 目标座位号（1~房间最大人数） */
+  seat: number;
+}
+
+/** 座位更新 */
+export interface SeatUpdateEvent {
+  /** 用户ID */
+  uid: number;
+  /** 原座位号 */
+  oldSeat: number;
+  /** 新座位号 */
+  newSeat: number;
+}
+
+/** 房间列表变更 */
+export interface RoomListChangedEvent {
+  /** 变更类型 */
+  type: string;
+}
+
+/** 恢复会话 */
+export interface ResumeSessionRequest {
+  /** 应用ID，需要与登录时的应用一致 */
+  appid: string;
+  /** 登录时返回的会话Token */
+  session: string;
+  /** 连续收到的最大消息序号（该序号及之前的消息均已收到） */
+  seq: number;
+}
+
+/** 消息丢弃通知 */
+export interface MessagesDroppedEvent {
+  /** 本次通知丢弃的消息数量 */
+  count: number;
+  /** 连接累计丢弃的消息数量 */
+  total: number;
+}
+
+/** 上报帧校验值 */
+export interface ReportChecksumRequest {
+  /** 帧序号（与FData的t一致） */
+  t: number;
+  /** 该帧的状态校验值 */
+  checksum: string;
+}
+
+/** 状态不同步通知 */
+export interface DesyncEvent {
+  /** 帧序号 */
+  t: number;
+  /** 校验值与多数玩家不一致的用户（数量相同时为所有用户） */
+  uids: Array<number>;
+  /** uid -> 上报的校验值 */
+  checksums: Record<number, string>;
+}
+
+/** 追帧 */
+export interface FrameCatchUpRequest {
+  /** 开始帧，0表示从第1帧开始 */
+  from?: number;
+}
+
+/** 追帧信息 */
+export interface CatchUpInfo {
+  /** 开始帧 */
+  from: number;
+  /** 开始追帧时的当前帧 */
+  t: number;
+}
+
+/** 追帧分片 */
+export interface CatchUpChunkEvent {
+  /** 分片的开始帧 */
+  from: number;
+  /** 分片的结束帧（包含） */
+  to: number;
+  /** 房间的当前帧 */
+  t: number;
+  /** 是否为最后一个分片，之后的帧通过FData下发 */
+  last: boolean;
+  /** gzip压缩的帧数据JSON：[{t: 帧序号, d: uid -> 操作列表}]（JSON编码时为base64） */
+  z: string;
+}
+
+/** 播放回放 */
+export interface PlayReplayRequest {
+  /** 回放ID */
+  id: string;
+  /** 播放速度，默认为1 */
+  speed?: number;
+  /** 快进到的帧，0表示从头播放 */
+  from?: number;
+}
+
+/** 回放信息 */
+export interface ReplayMeta {
+  /** 回放ID */
+  id: string;
+  /** 房间ID */
+  roomId: number;
+  /** 帧率 */
+  fps: number;
+  /** 帧同步模式 */
+  frameSync: FrameSyncOption;
+  /** 开始帧同步的时间（Unix毫秒） */
+  startTime: number;
+  /** 停止帧同步的时间（Unix毫秒） */
+  endTime: number;
+  /** 房主uid */
+  master: number;
+  /** 房间内的用户 */
+  users: Array<ReplayUser>;
+  /** 座位号 -> uid */
+  seats: Record<number, number>;
+  /** 房间自定义数据 */
+  customData: Record<string, any>;
+  /** 总帧数 */
+  frames: number;
+  /** 帧率变更（fps为开始时的帧率） */
+  rateChanges?: Array<FrameRateEvent>;
+}
+
+/** 回放中的用户 */
+export interface ReplayUser {
+  /** 用户ID */
+  uid: number;
+  /** 用户名称 */
+  name: string;
+  /** 座位号 */
+  seat: number;
+  /** 用户自定义数据 */
+  data: Record<string, any>;
+}
+
+/** 控制回放 */
+export interface ReplayControlRequest {
+  /** 控制操作 */
+  action: string;
+  /** seek跳转到的帧（跳转后已播放到该帧） */
+  t?: number;
+  /** speed修改的播放速度 */
+  speed?: number;
+}
+
+/** 回放状态 */
+export interface ReplayStateEvent {
+  /** 回放ID */
+  id: string;
+  /** 状态：playing、paused、reset（向前跳转，需重置到第0帧）、ended、stopped */
+  state: string;
+  /** 已播放的帧 */
+  t: number;
+  /** 总帧数 */
+  total: number;
+  /** 播放速度 */
+  speed: number;
+}
+
+/** 时间同步 */
+export interface TimeSyncRequest {
+  /** 客户端发送时的时间，原样返回 */
+  c?: number;
+}
+
+/** 时间同步的回复 */
+export interface TimeSyncReply {
+  /** 请求中的客户端时间 */
+  c: number;
+  /** 服务器时间（Unix毫秒） */
+  wall: number;
+  /** 服务器单调时间（服务器启动后的毫秒数） */
+  mono: number;
+  /** 房间当前帧，不在帧同步中时为0 */
+  t: number;
+  /** 帧间隔（毫秒），不在帧同步中时为0 */
+  interval: number;
+  /** 服务器测量的往返延迟（毫秒），0表示尚未测量 */
+  rtt: number;
+}
+
+/** 应用层心跳 */
+export interface PingEvent {
+  /** 服务器单调时间，Pong时原样返回 */
+  s: number;
+}
+
+/** 心跳回复 */
+export interface PongRequest {
+  /** Ping中的服务器单调时间 */
+  s: number;
+}
+
+/** 修改帧率 */
+export interface ChangeFrameRateRequest {
+  /** 新的帧率 */
+  fps: number;
+  /** 生效的帧序号，不传时为下一帧之后的一帧 */
+  at?: number;
+}
+
+/** 客户端请求的data */
+export interface RequestPayloads {
+  [ClientAction.Message]: any;
+  [ClientAction.CreateRoom]: CreateRoomRequest;
+  [ClientAction.JoinRoom]: JoinRoomRequest;
+  [ClientAction.StartFrameSync]: FrameSyncOption;
+  [ClientAction.UploadFrame]: any;
+  [ClientAction.Login]: LoginRequest;
+  [ClientAction.RoomMessage]: any;
+  [ClientAction.MatchUser]: MatchOption;
+  [ClientAction.UpdateUserData]: Record<string, any>;
+  [ClientAction.UpdateRoomCustomData]: Record<string, any>;
+  [ClientAction.UpdateRoomOption]: UpdateRoomOptionRequest;
+  [ClientAction.KickOut]: UidRequest;
+  [ClientAction.GetFrameAt]: GetFrameAtRequest;
+  [ClientAction.SetRoomState]: Record<string, any>;
+  [ClientAction.SetClientState]: Record<string, any>;
+  [ClientAction.MatchRoom]: MatchOption;
+  [ClientAction.SetRoomMatchOption]: MatchOption;
+  [ClientAction.GetRoomList]: GetRoomListRequest;
+  [ClientAction.SendServerMsg]: any;
+  [ClientAction.ListenerServer]: ListenerRequest;
+  [ClientAction.CannelListenerServer]: ListenerRequest;
+  [ClientAction.GetUserDataByUID]: UidRequest;
+  [ClientAction.GetServerOldMsg]: GetServerOldMsgRequest;
+  [ClientAction.ExtendsCall]: ExtendsCallRequest;
+  [ClientAction.SendToUser]: SendToUserRequest;
+  [ClientAction.QueryRoomList]: QueryRoomListRequest;
+  [ClientAction.SwitchSeat]: SwitchSeatRequest;
+  [ClientAction.ResumeSession]: ResumeSessionRequest;
+  [ClientAction.ReportChecksum]: ReportChecksumRequest;
+  [ClientAction.FrameCatchUp]: FrameCatchUpRequest;
+  [ClientAction.PlayReplay]: PlayReplayRequest;
+  [ClientAction.ReplayControl]: ReplayControlRequest;
+  [ClientAction.TimeSync]: TimeSyncRequest;
+  [ClientAction.Pong]: PongRequest;
+  [ClientAction.ChangeFrameRate]: ChangeFrameRateRequest;
+}
+
+/** 服务器直接回复的data */
+export interface ReplyPayloads {
+  [ClientAction.CreateRoom]: RoomIdReply;
+  [ClientAction.JoinRoom]: RoomIdReply;
+  [ClientAction.GetRoomData]: RoomData;
+  [ClientAction.UploadFrame]: UploadFrameReply;
+  [ClientAction.Login]: LoginReply;
+  [ClientAction.RoomMessage]: any;
+  [ClientAction.GetRoomOldMessage]: RoomOldMessageReply;
+  [ClientAction.GetFrameAt]: Array<Record<number, Array<any>>>;
+  [ClientAction.MatchRoom]: RoomIdReply;
+  [ClientAction.GetRoomList]: RoomListReply;
+  [ClientAction.GetUserDataByUID]: UserDataByUidReply;
+  [ClientAction.ExtendsCall]: any;
+  [ClientAction.QueryRoomList]: QueryRoomListReply;
+  [ClientAction.ResumeSession]: LoginReply;
+  [ClientAction.FrameCatchUp]: CatchUpInfo;
+  [ClientAction.PlayReplay]: ReplayMeta;
+  [ClientAction.ReplayControl]: ReplayStateEvent;
+  [ClientAction.TimeSync]: TimeSyncReply;
+  [ClientAction.ChangeFrameRate]: FrameRateEvent;
+}
+
+/** 服务器推送事件的data */
+export interface EventPayloads {
+  [ClientAction.Error]: ClientError;
+  [ClientAction.GetRoomData]: RoomData;
+  [ClientAction.FData]: FrameEvent;
+  [ClientAction.RoomMessage]: UserPayload;
+  [ClientAction.JoinRoomClient]: UserData;
+  [ClientAction.ExitRoomClient]: UserData;
+  [ClientAction.OutOnlineRoomClient]: UserData;
+  [ClientAction.RoomStateUpdate]: Record<string, any>;
+  [ClientAction.ClientStateUpdate]: UserPayload;
+  [ClientAction.FrameSyncReady]: FrameSyncOption;
+  [ClientAction.UpdateRoomUserData]: UserPayload;
+  [ClientAction.EVENT_GetServerMsg]: UserPayload;
+  [ClientAction.UserMessage]: UserPayload;
+  [ClientAction.SeatUpdate]: SeatUpdateEvent;
+  [ClientAction.EVENT_RoomListChanged]: RoomListChangedEvent;
+  [ClientAction.Batch]: Array<any>;
+  [ClientAction.MessagesDropped]: MessagesDroppedEvent;
+  [ClientAction.Desync]: DesyncEvent;
+  [ClientAction.FrameCatchUp]: CatchUpInfo;
+  [ClientAction.CatchUpChunk]: CatchUpChunkEvent;
+  [ClientAction.ReplayState]: ReplayStateEvent;
+  [ClientAction.Ping]: PingEvent;
+  [ClientAction.FrameRateChanged]: FrameRateEvent;
+}
@@ -60,7 +60,7 @@ func InitLogger(logpath string, level zapcore.Level, writeToConsole bool) {
 	var writeSyncer zapcore.WriteSyncer
 
 	if writeToConsole {
-		writeSyncer = zapcore.NewMultiWriteSyncer(zapcore.AddSync(os.Stdout)) // 打印到控制台
+		writeSyncer = zapcore.NewMultiWriteSyncer(zapcore.AddSync(os.Stderr)) // 打印到控制台（标准错误，不影响schema子命令的输出）
 	} else {
 		writeSyncer = zapcore.NewMultiWriteSyncer(zapcore.AddSync(&hook)) // 打印到文件
 	}
@@ -8,25 +8,42 @@ import (
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"flag"
+	"fmt"
 	"math/big"
 	stdnet "net"
 	"os"
 	"time"
 	"websocket_server/logs"
 	"websocket_server/net"
+	"websocket_server/websocketv2"
 
 	"go.uber.org/zap"
 )
 
 var (
 	port  = flag.Int("port", 8888, "端口号")
+	tcp   = flag.Int("tcp", 0, "TCP侦听端口号（长度前缀协议），0表示不开启")
 	wss   = flag.Int("wss", 0, "是否开启wss，开启请填1，默认为0")
 	ip    = flag.String("ip", "0.0.0.0", "ip地址")
 	model = flag.String("model", "debug", "日志模式：debug/product")
+	// 默认应用配置
+	compress          = flag.Int("compress", 0, "是否开启permessage-deflate压缩，开启请填1，默认为0")
+	compressThreshold = flag.Int("compress-threshold", 1024, "压缩的最小消息字节数，小于该值的消息不压缩")
+	backpressure      = flag.String("backpressure", "drop", "发送通道满时的处理策略：drop/drop-oldest/disconnect")
+	replays           = flag.String("replays", "", "帧同步回放的保存目录，为空时不录制回放")
+	recordReplay      = flag.Int("record-replay", 0, "是否录制所有房间的帧同步回放，开启请填1，默认只录制创建时指定record的房间")
+	frameHistory      = flag.Int("frame-history", 3600, "每个房间内存中保留的帧数，0表示全部保留在内存中")
+	frameRetention    = flag.String("frame-retention", "spill", "超出内存保留帧数后更早的帧的处理策略：spill（写入磁盘）/discard（丢弃）")
+	frameSpillDir     = flag.String("frame-spill-dir", "", "帧历史溢出文件的目录，为空时使用系统临时目录")
+	pingInterval      = flag.Int("ping-interval", 5000, "应用层心跳间隔（毫秒），用于测量往返延迟，0表示不开启")
 )
 
 func init() {
 	flag.Parse()
+	// 生成协议描述时不需要初始化日志
+	if flag.Arg(0) == "schema" {
+		return
+	}
 	logs.InitLogger("./logs.log", zap.DebugLevel, *model == "debug")
 	logs.InfoF("启动参数, port = %d, ip = %s\n", *port, *ip)
 }
@@ -76,12 +93,47 @@ func generateCert(certPath, keyPath string) error {
 	return nil
 }
 
+// 生成协议描述（JSON Schema、TypeScript、Haxe），修改op或data结构后执行`go generate`更新
+//go:generate go run . schema json docs/schema/hxonline.schema.json
+//go:generate go run . schema ts docs/schema/hxonline.ts
+//go:generate go run . schema haxe docs/schema/Protocol.hx
+
 func main() {
+	// 子命令：schema [json|ts|haxe] [输出文件]，未指定输出文件时输出到标准输出
+	if flag.Arg(0) == "schema" {
+		data, err := net.GenerateSchema(flag.Arg(1))
+		if err == nil {
+			if flag.Arg(2) != "" {
+				err = os.WriteFile(flag.Arg(2), data, 0644)
+			} else {
+				_, err = os.Stdout.Write(data)
+			}
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	s := net.Server{}
 	// 初始化
 	s.InitServer()
+	// 默认应用配置，可通过 s.SetAppOption 为指定AppId单独配置
+	net.DefaultAppOption.Compression = *compress == 1
+	net.DefaultAppOption.CompressionThreshold = *compressThreshold
+	net.DefaultAppOption.Backpressure = websocketv2.BackpressurePolicy(*backpressure)
+	net.DefaultAppOption.RecordReplay = *recordReplay == 1
+	net.DefaultAppOption.FrameHistory = *frameHistory
+	net.DefaultAppOption.FrameRetention = net.FrameRetention(*frameRetention)
+	net.DefaultAppOption.PingInterval = time.Duration(*pingInterval) * time.Millisecond
+	s.ReplayDir = *replays
+	s.FrameSpillDir = *frameSpillDir
 	// 注册V3的接口实现
 	// s.Register(extends.V3Api{})
+	// TCP侦听与WebSocket共用同一套App、房间与匹配
+	if *tcp != 0 {
+		go s.ListenTCP(*ip, *tcp)
+	}
 	if *wss == 1 {
 		if _, err := os.Stat("tls.pem"); os.IsNotExist(err) {
 			if _, err := os.Stat("tls.key"); os.IsNotExist(err) {
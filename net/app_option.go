@@ -0,0 +1,52 @@
+package net
+
+import (
+	"time"
+	"websocket_server/websocketv2"
+)
+
+// 应用配置，不同AppId可以使用不同的配置
+type AppOption struct {
+	Compression          bool                           // 是否启用permessage-deflate压缩（需客户端握手时支持该扩展）
+	CompressionThreshold int                            // 压缩的最小消息字节数，小于该值的消息不压缩
+	ResumeWindow         time.Duration                  // 连接断开后等待恢复会话的时间，0表示不开启可恢复会话
+	ReplayBufferSize     int                            // 每个会话回放缓冲区保存的最大消息数
+	Backpressure         websocketv2.BackpressurePolicy // 发送通道满（慢速客户端）时的处理策略：drop、drop-oldest、disconnect
+	PriorityLanes        bool                           // 是否开启优先通道，开启后FData、错误等消息优先发送，不会被普通消息（如聊天）挤占
+	RecordReplay         bool                           // 是否录制所有房间的帧同步回放（需设置Server.ReplayDir），关闭时仅录制创建时指定record的房间
+	FrameHistory         int                            // 每个房间内存中保留的帧数，0表示全部保留在内存中
+	FrameRetention       FrameRetention                 // 超出内存保留帧数后更早的帧的处理策略：spill（写入磁盘）、discard（丢弃）
+	PingInterval         time.Duration                  // 应用层心跳间隔，用于测量往返延迟，0表示不开启
+}
+
+// 默认应用配置，未单独配置的AppId使用该配置
+var DefaultAppOption = AppOption{
+	Compression:          false,
+	CompressionThreshold: 1024,
+	ResumeWindow:         30 * time.Second,
+	ReplayBufferSize:     512,
+	Backpressure:         websocketv2.DropNewest,
+	PriorityLanes:        true,
+	FrameHistory:         3600,
+	FrameRetention:       RetainSpill,
+	PingInterval:         5 * time.Second,
+}
+
+// 设置指定AppId的应用配置，需在该应用的用户登录前设置
+func (s *Server) SetAppOption(appid string, option AppOption) {
+	s.appOptions.Store(appid, &option)
+	app := s.apps.GetData(appid, nil)
+	if app != nil {
+		app.(*App).option = &option
+	}
+}
+
+// 获取AppId对应的应用配置
+func (s *Server) getAppOption(appid string) *AppOption {
+	option := s.appOptions.GetData(appid, nil)
+	if option != nil {
+		return option.(*AppOption)
+	}
+	defaultOption := DefaultAppOption
+	return &defaultOption
+}
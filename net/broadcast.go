@@ -0,0 +1,39 @@
+package net
+
+import "sync"
+
+// 预编码的广播消息：同一条消息对每种编解码器只编码一次，所有接收者共享编码结果，
+// 每个接收者的消息序号在发送时通过Codec.WithSeq写入
+type PreparedMessage struct {
+	message *ClientMessage
+	lock    sync.Mutex
+	encoded map[string][]byte // 编解码器名称 -> 编码结果（不含seq）
+}
+
+// 创建广播消息（广播消息均为服务器主动推送的事件）
+func NewPreparedMessage(data *ClientMessage) *PreparedMessage {
+	return &PreparedMessage{
+		message: &ClientMessage{
+			Op:   data.Op,
+			Data: data.Data,
+			Push: true,
+		},
+		encoded: map[string][]byte{},
+	}
+}
+
+// 获取指定编解码器的编码结果，首次获取时编码
+func (p *PreparedMessage) encode(c Codec) ([]byte, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	data, ok := p.encoded[c.Name()]
+	if ok {
+		return data, nil
+	}
+	data, err := c.Marshal(p.message)
+	if err != nil {
+		return nil, err
+	}
+	p.encoded[c.Name()] = data
+	return data, nil
+}
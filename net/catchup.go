@@ -0,0 +1,176 @@
+package net
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"time"
+	"websocket_server/logs"
+	"websocket_server/runtime"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 追帧参数
+const (
+	catchUpChunkFrames = 256                   // 每个分片最多包含的帧数
+	catchUpChunkBytes  = 256 * 1024            // 每个分片压缩前的最大字节数
+	catchUpInterval    = 20 * time.Millisecond // 分片的发送间隔
+)
+
+// 开始追帧：从from帧开始，按分片（gzip压缩的JSON）下发缺失的帧数据，追上当前帧后再恢复下发实时的FData。
+// 追帧期间房间不会给该用户下发FData，新产生的帧会包含在后续分片中，保证帧序号连续且不重复
+func (c *Client) startCatchUp(from int) (*CatchUpInfo, error) {
+	r := c.room
+	if r == nil || !r.frameSync {
+		return nil, fmt.Errorf("帧同步未开启，无法追帧")
+	}
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	if c.catchingUp {
+		return nil, fmt.Errorf("正在追帧")
+	}
+	if from < 1 {
+		from = 1
+	}
+	if from < r.history.oldest() {
+		return nil, fmt.Errorf("第%d帧已被清除，最早可获取第%d帧", from, r.history.oldest())
+	}
+	if tick := r.visibleTick(c); from > tick+1 {
+		return nil, fmt.Errorf("帧范围无效，有效范围为1~%d", tick+1)
+	}
+	c.catchingUp = true
+	return c.launchCatchUp(r, from), nil
+}
+
+// 断线重新加入帧同步中的房间时，先暂停给该用户下发FData，登录完成后再从第1帧开始追帧。
+// 协议版本不支持追帧的客户端不暂停，仍直接接收之后的FData
+func (r *Room) holdFrames(c *Client) {
+	if !opAllowed(FrameCatchUp, c.version) {
+		return
+	}
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	c.catchingUp = true
+}
+
+// 开始已暂停FData的用户的追帧，没有暂停时返回nil
+func (c *Client) startHeldCatchUp() *CatchUpInfo {
+	r := c.room
+	if r == nil || !opAllowed(FrameCatchUp, c.version) {
+		return nil
+	}
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	if !c.catchingUp {
+		return nil
+	}
+	// 更早的帧已被清除时，从可获取到的最早一帧开始
+	return c.launchCatchUp(r, r.history.oldest())
+}
+
+// 启动追帧协程，需要在帧锁内调用
+func (c *Client) launchCatchUp(r *Room, from int) *CatchUpInfo {
+	go c.runCatchUp(r, from)
+	return &CatchUpInfo{From: from, T: r.visibleTick(c)}
+}
+
+// 按固定间隔下发追帧分片
+func (c *Client) runCatchUp(r *Room, next int) {
+	defer runtime.GoRecover()
+	for {
+		chunk, done := r.sendCatchUpChunk(c, next)
+		if chunk == nil {
+			return
+		}
+		if done {
+			logs.InfoM(c.name, "追帧完成，房间ID:", r.id, "帧:", chunk.To)
+			return
+		}
+		next = chunk.To + 1
+		time.Sleep(catchUpInterval)
+	}
+}
+
+// 下发从next帧开始的一个分片。分片在帧锁内入队，最后一个分片一定先于之后的FData送达
+func (r *Room) sendCatchUpChunk(c *Client, next int) (*CatchUpChunkEvent, bool) {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	chunk, done := r.takeCatchUpChunk(c, next)
+	if chunk != nil {
+		c.SendToUserOp(&ClientMessage{
+			Op:   CatchUpChunk,
+			Data: chunk,
+		})
+	}
+	return chunk, done
+}
+
+// 取出从next帧开始的一个分片，已追上当前帧时结束追帧（done=true），之后的帧通过FData下发。
+// 帧同步停止、用户离开房间时结束追帧并返回nil。需要在帧锁内调用
+func (r *Room) takeCatchUpChunk(c *Client, next int) (*CatchUpChunkEvent, bool) {
+	if c.room != r || !r.frameSync || !c.isConnected() {
+		c.catchingUp = false
+		return nil, false
+	}
+	frames := make([]FrameEvent, 0, catchUpChunkFrames)
+	size := 0
+	to := next - 1
+	// 观战用户只能追到已延迟下发的帧
+	last := r.visibleTick(c)
+	for t := next; t <= last && len(frames) < catchUpChunkFrames; t++ {
+		d, err := r.history.get(t)
+		if err != nil {
+			logs.InfoM("追帧失败：", err)
+			c.catchingUp = false
+			return nil, false
+		}
+		frame := FrameEvent{T: t, D: d}
+		v, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(frame)
+		if err != nil {
+			logs.InfoM("追帧数据编码失败：", err)
+			break
+		}
+		if size+len(v) > catchUpChunkBytes && len(frames) > 0 {
+			break
+		}
+		size += len(v)
+		frames = append(frames, frame)
+		to = t
+	}
+	z, err := gzipJSON(frames)
+	if err != nil {
+		logs.InfoM("追帧数据压缩失败：", err)
+		c.catchingUp = false
+		return nil, false
+	}
+	done := to >= last
+	if done {
+		// 在帧锁内结束追帧，下一帧开始正常下发FData
+		c.catchingUp = false
+	}
+	return &CatchUpChunkEvent{
+		From: next,
+		To:   to,
+		T:    last,
+		Last: done,
+		Z:    z,
+	}, done
+}
+
+// 编码为JSON并使用gzip压缩
+func gzipJSON(v any) ([]byte, error) {
+	data, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err = w.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
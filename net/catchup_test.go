@@ -0,0 +1,139 @@
+package net
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 解压分片中的帧数据
+func decodeCatchUpChunk(t *testing.T, chunk *CatchUpChunkEvent) []FrameEvent {
+	t.Helper()
+	r, err := gzip.NewReader(bytes.NewReader(chunk.Z))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var frames []FrameEvent
+	if err := jsoniter.ConfigCompatibleWithStandardLibrary.NewDecoder(r).Decode(&frames); err != nil {
+		t.Fatal(err)
+	}
+	return frames
+}
+
+func TestCatchUpChunks(t *testing.T) {
+	large := strings.Repeat("x", catchUpChunkBytes/3)
+	tests := []struct {
+		name      string
+		ticks     int
+		data      any
+		spectator int // 观战用户已延迟下发到的帧，0表示玩家
+		from      int
+		chunks    []int // 每个分片的结束帧
+	}{
+		{"按帧数分片", catchUpChunkFrames*2 + 10, "a", 0, 1, []int{catchUpChunkFrames, catchUpChunkFrames * 2, catchUpChunkFrames*2 + 10}},
+		{"按大小分片", 5, large, 0, 1, []int{2, 4, 5}},
+		{"从中间开始", 10, "a", 0, 6, []int{10}},
+		{"已追上", 10, "a", 0, 11, []int{10}},
+		{"观战延迟", 10, "a", 7, 1, []int{7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Room{frameSync: true, history: &frameHistory{first: 1, cached: -1}}
+			for i := 1; i <= tt.ticks; i++ {
+				r.history.push(map[int][]any{1: {tt.data}}, 0)
+				r.cacheId++
+			}
+			c := &Client{room: r, catchingUp: true}
+			c.setConnected(true)
+			last := tt.ticks
+			if tt.spectator > 0 {
+				c.spectator = true
+				r.spectatorTick = tt.spectator
+				last = tt.spectator
+			}
+			next := tt.from
+			for i, to := range tt.chunks {
+				chunk, done := r.takeCatchUpChunk(c, next)
+				if chunk == nil {
+					t.Fatalf("chunk %d is nil", i)
+				}
+				if chunk.From != next || chunk.To != to || chunk.T != last {
+					t.Fatalf("chunk %d = %d~%d (t=%d), want %d~%d (t=%d)", i, chunk.From, chunk.To, chunk.T, next, to, last)
+				}
+				frames := decodeCatchUpChunk(t, chunk)
+				if len(frames) != to-next+1 {
+					t.Fatalf("chunk %d has %d frames, want %d", i, len(frames), to-next+1)
+				}
+				for j, frame := range frames {
+					if frame.T != next+j || len(frame.D[1]) != 1 {
+						t.Fatalf("chunk %d frame %d = %+v", i, j, frame)
+					}
+				}
+				final := i == len(tt.chunks)-1
+				if done != final || chunk.Last != final || c.catchingUp == final {
+					t.Fatalf("chunk %d: done=%v last=%v catchingUp=%v", i, done, chunk.Last, c.catchingUp)
+				}
+				next = to + 1
+			}
+		})
+	}
+}
+
+func connectedTestClient(r *Room) *Client {
+	c := &Client{room: r}
+	c.setConnected(true)
+	return c
+}
+
+// 帧同步停止或离开房间时结束追帧
+func TestCatchUpStopped(t *testing.T) {
+	r := &Room{frameSync: true, history: &frameHistory{first: 1, cached: -1}}
+	r.history.push(map[int][]any{}, 0)
+	r.cacheId = 1
+	tests := []struct {
+		name string
+		c    *Client
+		sync bool
+	}{
+		{"帧同步停止", connectedTestClient(r), false},
+		{"离开房间", connectedTestClient(nil), true},
+		{"断线", &Client{room: r}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r.frameSync = tt.sync
+			tt.c.catchingUp = true
+			if chunk, _ := r.takeCatchUpChunk(tt.c, 1); chunk != nil || tt.c.catchingUp {
+				t.Fatalf("chunk = %+v, catchingUp = %v", chunk, tt.c.catchingUp)
+			}
+		})
+	}
+}
+
+// 协议版本不支持追帧的客户端不暂停FData
+func TestHoldFramesVersion(t *testing.T) {
+	tests := []struct {
+		version int
+		held    bool
+	}{
+		{1, false},
+		{opVersions[FrameCatchUp] - 1, false},
+		{opVersions[FrameCatchUp], true},
+	}
+	for _, tt := range tests {
+		r := &Room{frameSync: true, history: &frameHistory{first: 1, cached: -1}}
+		// 未连接的用户，追帧协程不会下发分片
+		c := &Client{room: r, version: tt.version}
+		r.holdFrames(c)
+		if c.catchingUp != tt.held {
+			t.Fatalf("version %d: catchingUp = %v, want %v", tt.version, c.catchingUp, tt.held)
+		}
+		info := c.startHeldCatchUp()
+		if (info != nil) != tt.held {
+			t.Errorf("version %d: catch-up started = %v", tt.version, info != nil)
+		}
+	}
+}
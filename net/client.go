@@ -2,11 +2,9 @@ package net
 
 import (
 	"runtime"
+	"sync/atomic"
 	"websocket_server/logs"
 	"websocket_server/util"
-	"websocket_server/websocketv2"
-
-	jsoniter "github.com/json-iterator/go"
 )
 
 type ClientAction int
@@ -64,11 +62,30 @@ const (
 	SwitchSeat                 ClientAction = 48 // 更换座位
 	SeatUpdate                 ClientAction = 49 // 座位更新通知
 	EVENT_RoomListChanged            ClientAction = 50 // 房间列表变更通知
+	ResumeSession              ClientAction = 51 // 恢复会话（断线重连时使用，data: {session: 登录返回的会话Token, seq: 最后收到的消息序号}）
+	Batch                      ClientAction = 52 // 合并下发的消息（登录时batch=true开启），data为多条完整消息组成的数组，需按顺序处理
+	MessagesDropped            ClientAction = 53 // 发送通道积压导致消息被丢弃的通知，data: {count: 本次通知丢弃的数量, total: 累计丢弃的数量}
+	ReportChecksum             ClientAction = 54 // 上报指定帧的状态校验值（data: {t: 帧序号, checksum: 校验值}），用于检测客户端之间的状态不同步
+	Desync                     ClientAction = 55 // 状态不同步通知，data: {t: 帧序号, uids: 校验值与多数玩家不一致的用户, checksums: uid -> 校验值}
+	FrameCatchUp               ClientAction = 56 // 追帧（data: {from: 开始帧}），断线重新加入帧同步中的房间时服务器会自动推送，data: {from: 开始帧, t: 当前帧}
+	CatchUpChunk               ClientAction = 57 // 追帧分片，data: {from, to, t: 当前帧, last: 是否为最后一个分片, z: gzip压缩的帧数据JSON}
+	PlayReplay                 ClientAction = 58 // 播放回放（data: {id: 回放ID, speed: 播放速度, from: 跳转到的帧}），回复回放信息，之后按原始帧率下发FData
+	ReplayControl              ClientAction = 59 // 控制回放（data: {action: pause/resume/seek/speed/stop, t: 跳转的帧, speed: 1/2/4}）
+	ReplayState                ClientAction = 60 // 回放状态变化通知，data: {id, state: playing/paused/reset/ended/stopped, t: 已播放的帧, total: 总帧数, speed}
+	TimeSync                   ClientAction = 61 // 时间同步（data: {c: 客户端时间}），回复 {c, wall: 服务器时间, mono: 服务器单调时间, t: 当前帧, interval: 帧间隔, rtt}
+	Ping                       ClientAction = 62 // 应用层心跳，data: {s: 服务器单调时间}，客户端收到后需回复Pong
+	Pong                       ClientAction = 63 // 心跳回复（data: {s: Ping中的s}），服务器据此计算往返延迟，不会回复
+	ChangeFrameRate            ClientAction = 64 // 修改帧同步中的帧率（房主操作，data: {fps, at: 生效的帧序号}），回复帧率变更
+	FrameRateChanged           ClientAction = 65 // 帧率变更通知，data: {fps, at: 生效的帧序号, prev: 变更前的帧率}
 )
 
 type ClientMessage struct {
-	Op   ClientAction `json:"op"`   // 客户端行为
-	Data any          `json:"data"` // 客户端数据
+	Op   ClientAction `json:"op"`             // 客户端行为
+	Data any          `json:"data"`           // 客户端数据
+	Id   int          `json:"id,omitempty"`   // 请求ID（可选），服务器会在该请求的直接回复与错误中原样返回
+	Push bool         `json:"push,omitempty"` // 是否为服务器主动推送的事件（如FData、RoomStateUpdate），直接回复不会带有该标记
+	Seq  int          `json:"seq,omitempty"`  // 下发消息的序号（开启可恢复会话后，每条下发消息按顺序递增编号）
+	Tick int          `json:"tick,omitempty"` // UploadFrame：客户端期望该操作生效的帧序号（可选）
 }
 
 type ClientError struct {
@@ -96,18 +113,32 @@ const (
 	ROOM_NOT_EXSIT         ClientErrorCode = 1014 // 房间不存在
 	ROOM_PERMISSION_DENIED ClientErrorCode = 1015 // 房间权限不足
 	DATA_ERROR             ClientErrorCode = 1016 // 数据结果错误
+	SESSION_ERROR          ClientErrorCode = 1017 // 会话恢复失败
+	FRAMES_DISCARDED       ClientErrorCode = 1018 // 观战延迟期间帧已被清除，无法下发
 )
 
 type Client struct {
-	*websocketv2.WebSocket              // WebSocket基础类
-	room                   *Room        // 房间（每个用户只会进入到一个房间中）
-	userData               *util.Map    // 用户自定义数据
-	frames                 *util.Array  // 用户帧同步缓存操作
-	uid                    int          // 用户ID
-	name                   string       // 用户名称
-	seat                   int          // 房间座位号（1~maxCounts，0=未分配）
-	matchOption            *MatchOption // 房间匹配参数
-	appid                  string       // 绑定的AppId
+	Transport                 // 传输层连接（WebSocket、TCP、SSE）
+	connected   int32         // 连接是否可用（1为可用，原子访问）
+	room        *Room         // 房间（每个用户只会进入到一个房间中）
+	userData    *util.Map     // 用户自定义数据
+	frames      *util.Array   // 用户帧同步缓存操作
+	uid         int           // 用户ID
+	name        string        // 用户名称
+	seat        int           // 房间座位号（1~maxCounts，0=未分配）
+	matchOption *MatchOption  // 房间匹配参数
+	appid       string        // 绑定的AppId
+	codec       Codec         // 连接协商的编解码器
+	session     *Session      // 可恢复会话（未开启时为nil）
+	batch       bool          // 是否开启消息合并下发
+	version     int           // 登录时协商的协议版本
+	catchingUp  bool          // 是否正在追帧（由房间的frameMu保护）
+	playback    *replayPlayer // 正在播放的回放
+	spectator   bool          // 是否为观战用户
+	compact     bool          // 是否开启紧凑帧数据
+	noAck       bool          // 上传帧数据时是否只在出错时回复
+	rtt         int64         // 平滑后的往返延迟（纳秒，原子访问）
+	pingAt      int64         // 上一次发送应用层心跳的时间（服务器单调时间毫秒，原子访问）
 }
 
 // 发送数据给所有人
@@ -124,32 +155,143 @@ func (c *Client) getApp() *App {
 
 // 单独发送数据到当前用户（直接投递到发送通道，由writeMessage协程消费）
 func (c *Client) SendToUser(data []byte) {
-	if c.Connected {
+	if c.isConnected() {
+		c.SendBytes(data)
+	}
+}
+
+// 高优先级的消息，开启优先通道后不会因普通消息积压而延迟或丢弃。
+// 追帧分片需要与FData走同一通道，保证衔接时的顺序
+var priorityOps = map[ClientAction]bool{
+	Error:            true,
+	FData:            true,
+	FrameSyncReady:   true,
+	FrameCatchUp:     true,
+	CatchUpChunk:     true,
+	TimeSync:         true,
+	Ping:             true,
+	FrameRateChanged: true, // 帧率变更需要先于生效帧的FData送达
+}
+
+// 按消息类型选择发送通道
+func (c *Client) sendToUser(op ClientAction, data []byte) {
+	if !c.isConnected() {
+		return
+	}
+	if priorityOps[op] && c.getApp().option.PriorityLanes {
+		c.SendBytesPriority(data)
+	} else {
 		c.SendBytes(data)
 	}
 }
 
-// 发送客户端数据到当前用户
+// 按应用配置设置连接参数（压缩、消息合并、背压策略），登录与恢复会话时调用
+func (c *Client) applyConnOption() {
+	option := c.getApp().option
+	c.SetCompression(option.Compression, option.CompressionThreshold)
+	// 客户端支持Batch消息时，开启消息合并下发
+	if c.batch {
+		c.SetBatcher(c.codec.Batch)
+	}
+	c.SetBackpressure(option.Backpressure)
+	// 丢弃消息后通知客户端，该通知不编号也不进入回放缓冲区
+	c.SetDropNotice(func(count int) []byte {
+		v, _ := c.codec.Marshal(&ClientMessage{
+			Op: MessagesDropped,
+			Data: map[string]any{
+				"count": count,
+				"total": c.Dropped(),
+			},
+			Push: true,
+		})
+		return v
+	})
+}
+
+// 发送客户端数据到当前用户（服务器主动推送的事件，会带有push标记）
 func (c *Client) SendToUserOp(data *ClientMessage) {
+	c.sendOp(data, 0, true)
+}
+
+// 回复客户端的请求，会回显请求ID，方便客户端对应请求与回复
+func (c *Client) ReplyOp(request *ClientMessage, data *ClientMessage) {
+	c.sendOp(data, request.Id, false)
+}
+
+// 发送消息，id为回显的请求ID，push表示是否为主动推送的事件
+func (c *Client) sendOp(data *ClientMessage, id int, push bool) {
 	if data != nil {
 		// 指针会有nil丢失的情况，保护数据
 		var value ClientMessage = ClientMessage{
 			Op:   data.Op,
 			Data: data.Data,
+			Id:   id,
+			Push: push,
 		}
-		v, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(value)
+		v, err := c.codec.Marshal(&value)
 		if err == nil {
-			c.SendToUser(v)
+			c.sendEncoded(&value, v)
 		}
 	}
 }
 
+// 发送广播消息，编码结果在所有接收者之间共享
+func (c *Client) SendPrepared(p *PreparedMessage) {
+	v, err := p.encode(c.codec)
+	if err == nil {
+		c.sendEncoded(p.message, v)
+	}
+}
+
+// 发送已编码（不含seq）的消息，开启会话时需要编号并写入回放缓冲区，
+// 发送也在锁内完成，保证同一通道内的发送顺序与序号一致
+func (c *Client) sendEncoded(message *ClientMessage, data []byte) {
+	s := c.session
+	if s != nil {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		data = c.codec.WithSeq(data, s.record(message))
+	}
+	// 优先通道会使序号乱序到达，恢复会话时客户端上报连续收到的最大序号，服务器补发之后的所有消息
+	c.sendToUser(message.Op, data)
+}
+
+// 用户是否在线（连接断开但会话等待恢复时，仍视为在线）
+func (c *Client) isOnline() bool {
+	return c.isConnected() || c.isSuspended()
+}
+
+// 连接是否可用，连接协程与帧调度、心跳等协程会同时访问
+func (c *Client) isConnected() bool {
+	return atomic.LoadInt32(&c.connected) == 1
+}
+
+func (c *Client) setConnected(connected bool) {
+	var v int32
+	if connected {
+		v = 1
+	}
+	atomic.StoreInt32(&c.connected, v)
+}
+
 // 用户离线时触发
 func (c *Client) OnUserOut() {
 	// 如果存在房间时，则需要退出房间
 	logs.InfoM("用户" + c.name + ".OnUserOut")
-	c.Connected = false
-	if c.room != nil {
+	c.setConnected(false)
+	// 存在可恢复会话时，等待客户端恢复，超时后再执行离线处理
+	if c.suspendSession() {
+		return
+	}
+	c.onOffline()
+}
+
+// 用户离线处理
+func (c *Client) onOffline() {
+	if c.room != nil && c.spectator {
+		// 观战用户离线时直接退出观战
+		c.room.ExitClient(c)
+	} else if c.room != nil {
 		logs.InfoM("用户" + c.name + "退出房间")
 		// 如果房间存在，而且房间没有锁定时，离线则可以直接退出房间
 		if c.room.isInvalidRoom() {
@@ -167,6 +309,8 @@ func (c *Client) OnUserOut() {
 		// 从服务器列表中删除
 	}
 	c.getApp().users.Remove(c)
+	// 停止正在播放的回放
+	c.stopPlayback()
 	// 从所有服务器通知侦听中删除
 	c.getApp().removeAllListeners(c)
 	// 从服务器匹配列表中取消
@@ -179,6 +323,7 @@ func (c *Client) OnUserOut() {
 	}
 }
 
+// 发送错误消息（不对应具体请求的错误，如在别处登陆）
 func (c *Client) SendError(errCode ClientErrorCode, op ClientAction, data string) {
 	c.SendToUserOp(&ClientMessage{
 		Op: Error,
@@ -190,13 +335,28 @@ func (c *Client) SendError(errCode ClientErrorCode, op ClientAction, data string
 	})
 }
 
+// 回复请求的错误消息，会回显请求ID
+func (c *Client) ReplyError(request *ClientMessage, errCode ClientErrorCode, data string) {
+	c.ReplyOp(request, &ClientMessage{
+		Op: Error,
+		Data: ClientError{
+			Code: errCode,
+			Op:   request.Op,
+			Msg:  data,
+		},
+	})
+}
+
 // 获取用户数据
 func (c *Client) GetUserData() any {
 	data := map[string]any{}
 	data["uid"] = c.uid
 	data["name"] = c.name
 	data["seat"] = c.seat
+	data["spectator"] = c.spectator
 	data["data"] = c.userData.Data
+	data["dropped"] = c.Dropped()
+	data["rtt"] = c.Rtt()
 	return data
 }
 
@@ -205,17 +365,20 @@ func (c *Client) GetRegisterUserData() *RegisterUserData {
 	return c.getApp().usersSQL.GetUserDataByUid(c.uid)
 }
 
-// 创建客户端对象
-func CreateClient(c *websocketv2.WebSocket) *Client {
+// 创建客户端对象，并开始收发消息
+func CreateClient(t Transport) *Client {
 	client := &Client{
 		userData: util.CreateMap(),
 		frames:   util.CreateArray(),
 	}
-	client.WebSocket = c
-	client.Connected = true
+	client.Transport = t
+	client.setConnected(true)
+	// 根据握手协商的子协议选择编解码器
+	client.codec = getCodec(t.Subprotocol())
+	client.SetBinary(client.codec.Binary())
 	// 创建Handle绑定
 	logs.InfoM("线程数量：", runtime.NumGoroutine())
-	client.OnUserOutCallback = client.OnUserOut
-	client.OnWorkData = client.OnMessage
+	client.SetHandler(client.OnMessage, client.OnUserOut)
+	t.Start()
 	return client
 }
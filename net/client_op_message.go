@@ -5,8 +5,6 @@ import (
 	"time"
 	"websocket_server/logs"
 	"websocket_server/util"
-
-	jsoniter "github.com/json-iterator/go"
 )
 
 const loginTimeout = 10 * time.Second // 未登录连接的最大存活时间
@@ -15,24 +13,16 @@ const loginTimeout = 10 * time.Second // 未登录连接的最大存活时间
 func (c *Client) OnMessage(data []byte) {
 	// 解析API操作
 	message := &ClientMessage{}
-	var err error
-	// 如果是二进制数据，则需要解析处理，第一位是op操作符，剩余的是内容
-	err = jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal(data, &message)
-	// 如果无法以JSON解析时，则使用二进制解析
-	if err != nil {
-		if len(data) == 0 {
-			c.SendError(OP_ERROR, 0, "空消息")
-			return
-		}
-		op := ClientAction(data[0])
-		content := data[1:]
-		message.Op = op
-		err = jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal(content, &message.Data)
+	if len(data) == 0 {
+		c.SendError(OP_ERROR, 0, "空消息")
+		return
 	}
+	// 使用连接协商的编解码器解析
+	err := c.codec.Unmarshal(data, message)
 	if err == nil {
 		// 未登录连接超时检测：超过 loginTimeout 仍未登录则踢出
-		if c.uid == 0 && message.Op != Login && c.IsLoginTimeout(loginTimeout) {
-			c.SendError(LOGIN_ERROR, message.Op, "登录超时，连接已关闭")
+		if c.uid == 0 && message.Op != Login && message.Op != ResumeSession && c.IsLoginTimeout(loginTimeout) {
+			c.ReplyError(message, LOGIN_ERROR, "登录超时，连接已关闭")
 			c.Close()
 			return
 		}
@@ -42,73 +32,143 @@ func (c *Client) OnMessage(data []byte) {
 			switch message.Op {
 			case Login:
 				if c.uid == 0 {
-					loginData := message.Data.(map[string]any)
-					userName := loginData["username"]
-					openId := loginData["openid"]
-					appId := util.GetMapValueToString(loginData, "appid")
-					if appId == "" {
-						c.SendError(LOGIN_ERROR, message.Op, "需要提供appid")
-						c.Close()
-						return
-					}
-					if userName == nil || openId == nil {
-						c.SendError(LOGIN_ERROR, message.Op, "需要提供openid和username")
+					loginData := &LoginRequest{}
+					if err := decodeData(message.Data, loginData); err != nil {
+						c.ReplyError(message, LOGIN_ERROR, err.Error())
 						c.Close()
 						return
 					}
 					// 绑定AppId
-					logs.InfoM("准备登录：", openId.(string))
-					c.appid = appId
+					logs.InfoM("准备登录：", loginData.OpenId)
+					c.appid = loginData.AppId
+					// 协商协议版本，不同版本可调用的op不同（在加入用户列表之前设置，心跳协程会读取）
+					c.version = negotiateVersion(loginData.Version)
 					c.getApp().users.Push(c)
+					// 客户端支持Batch消息时，开启消息合并下发
+					c.batch = loginData.Batch
+					// 客户端支持紧凑帧数据时，FData合并空帧、省略重复的操作
+					c.compact = loginData.Compact
+					// 上传帧数据只在出错时回复
+					c.noAck = loginData.NoAck
+					// 按应用配置设置压缩、背压策略等连接参数
+					c.applyConnOption()
 					// 只需要用户名和OpenId即可登陆
-					userData := c.getApp().usersSQL.login(c, openId.(string), userName.(string))
-					logs.InfoM("登陆成功：", openId.(string), userData)
-					c.SendToUserOp(&ClientMessage{
-						Op: Login,
-						Data: map[string]any{
-							"uid": userData.uid,
-						},
+					userData := c.getApp().usersSQL.login(c, loginData.OpenId, loginData.Username)
+					logs.InfoM("登陆成功：", loginData.OpenId, userData)
+					// 开启可恢复会话
+					c.startSession()
+					reply := map[string]any{
+						"uid": userData.uid,
+					}
+					if c.session != nil {
+						reply["session"] = c.session.token
+					}
+					c.ReplyOp(message, &ClientMessage{
+						Op:   Login,
+						Data: c.protocolInfo(reply),
 					})
+					// 重新加入帧同步中的房间时，通知当前帧并开始追帧
+					if info := c.startHeldCatchUp(); info != nil {
+						c.SendToUserOp(&ClientMessage{
+							Op:   FrameCatchUp,
+							Data: info,
+						})
+					}
 				} else {
-					c.SendToUserOp(&ClientMessage{
+					c.ReplyOp(message, &ClientMessage{
 						Op: Login,
-						Data: map[string]any{
+						Data: c.protocolInfo(map[string]any{
 							"uid": c.uid,
-						},
+						}),
 					})
 				}
-		default:
-				c.SendError(OP_ERROR, message.Op, "无效的操作指令："+fmt.Sprint(message.Op))
+			case ResumeSession:
+				// 恢复会话，恢复成功后当前连接会绑定到原有的用户上
+				req, ok := decodeRequest[ResumeSessionRequest](c, message)
+				if !ok {
+					return
+				}
+				user, err := c.resumeSession(req.AppId, req.Session, req.Seq)
+				if err != nil {
+					c.ReplyError(message, SESSION_ERROR, err.Error())
+					return
+				}
+				user.ReplyOp(message, &ClientMessage{
+					Op: ResumeSession,
+					Data: user.protocolInfo(map[string]any{
+						"uid": user.uid,
+					}),
+				})
+			default:
+				c.ReplyError(message, OP_ERROR, "无效的操作指令："+fmt.Sprint(message.Op))
 			}
 			return
 		}
+		// 按协商的协议版本限制可调用的op
+		if !opAllowed(message.Op, c.version) {
+			if _, ok := opVersions[message.Op]; ok {
+				c.ReplyError(message, OP_ERROR, fmt.Sprintf("操作指令%d需要协议版本%d，当前协议版本为%d", message.Op, opVersions[message.Op], c.version))
+			} else {
+				c.ReplyError(message, OP_ERROR, "无效的操作指令："+fmt.Sprint(message.Op))
+			}
+			return
+		}
+		if c.spectator && spectatorDeniedOps[message.Op] {
+			c.ReplyError(message, ROOM_PERMISSION_DENIED, "观战用户无法执行该操作")
+			return
+		}
 		switch message.Op {
 		case SwitchSeat:
 			if c.room != nil {
-				targetSeat := util.GetMapValueToInt(message.Data, "seat")
-				err := c.room.SwitchSeat(c, targetSeat)
+				req, ok := decodeRequest[SwitchSeatRequest](c, message)
+				if !ok {
+					return
+				}
+				err := c.room.SwitchSeat(c, req.Seat)
 				if err != nil {
-					c.SendError(OP_ERROR, message.Op, err.Error())
+					c.ReplyError(message, OP_ERROR, err.Error())
 				}
 			} else {
-				c.SendError(OP_ERROR, message.Op, "不在房间中，无法更换座位")
+				c.ReplyError(message, OP_ERROR, "不在房间中，无法更换座位")
 			}
 		case Message:
 			// 接收到消息
 			fmt.Println("服务器接收到消息：", message.Data)
 		case CreateRoom:
 			if c.matchOption != nil {
-				c.SendError(JOIN_ROOM_ERROR, message.Op, "正在匹配中")
+				c.ReplyError(message, JOIN_ROOM_ERROR, "正在匹配中")
 				return
 			}
 			// 创建一个房间（客户端可传入 fps 自定义帧率，不传则默认 30）
-			room := c.getApp().CreateRoom(c, RoomConfigOption{
-				fps: float64(util.GetMapValueToInt(message.Data, "fps")),
-			})
+			req, ok := decodeRequest[CreateRoomRequest](c, message)
+			if !ok {
+				return
+			}
+			if req.Validator != "" && CurrentServer.frameValidators[req.Validator] == nil {
+				c.ReplyError(message, CREATE_ROOM_ERROR, "帧操作校验器"+req.Validator+"不存在")
+				return
+			}
+			option := RoomConfigOption{
+				fps:            req.FPS,
+				record:         req.Record,
+				maxSpectators:  defaultSpectatorCounts,
+				spectatorDelay: defaultSpectatorDelay,
+				validator:      req.Validator,
+				maxInputs:      req.MaxInputs,
+				maxInputSize:   req.MaxInputSize,
+			}
+			if req.MaxSpectators != nil {
+				option.maxSpectators = *req.MaxSpectators
+			}
+			if req.SpectatorDelay != nil {
+				option.spectatorDelay = *req.SpectatorDelay
+			}
+			option.frameSync.merge(req.FrameSync)
+			room := c.getApp().CreateRoom(c, option)
 			logs.InfoM("开始创建房间", room)
 			if room != nil {
 				// 创建成功
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: CreateRoom,
 					Data: map[string]any{
 						"id": room.id,
@@ -116,107 +176,278 @@ func (c *Client) OnMessage(data []byte) {
 				})
 			} else {
 				// 创建失败
-				c.SendError(CREATE_ROOM_ERROR, message.Op, "房间已存在，无法创建")
+				c.ReplyError(message, CREATE_ROOM_ERROR, "房间已存在，无法创建")
 			}
 		case GetRoomData:
 			// 获取房间信息
 			if c.room != nil {
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op:   GetRoomData,
 					Data: c.room.GetRoomData(),
 				})
 			} else {
-				c.SendError(GET_ROOM_ERROR, message.Op, "不存在房间信息")
+				c.ReplyError(message, GET_ROOM_ERROR, "不存在房间信息")
 			}
 		case JoinRoom:
 			if c.matchOption != nil {
-				c.SendError(JOIN_ROOM_ERROR, message.Op, "正在匹配中")
+				c.ReplyError(message, JOIN_ROOM_ERROR, "正在匹配中")
 				return
 			}
 			if c.room != nil {
-				c.SendError(JOIN_ROOM_ERROR, message.Op, "已存在房间，无法加入")
+				c.ReplyError(message, JOIN_ROOM_ERROR, "已存在房间，无法加入")
 			} else {
-				id := util.GetMapValueToInt(message.Data, "id")
-				password := util.GetMapValueToString(message.Data, "password")
-				room, err := c.getApp().JoinRoom(c, id, password)
+				req, ok := decodeRequest[JoinRoomRequest](c, message)
+				if !ok {
+					return
+				}
+				join := c.getApp().JoinRoom
+				if req.Spectate {
+					join = c.getApp().SpectateRoom
+				}
+				room, err := join(c, req.Id, req.Password)
 				if err == nil {
-					c.SendToUserOp(&ClientMessage{
+					c.ReplyOp(message, &ClientMessage{
 						Op: JoinRoom,
 						Data: map[string]any{
 							"id": room.id,
 						}},
 					)
+					if req.Spectate && room.frameSync && opAllowed(FrameCatchUp, c.version) {
+						// 帧同步中加入观战时，通过追帧获取已延迟下发的帧
+						if info, err := c.startCatchUp(1); err == nil {
+							c.SendToUserOp(&ClientMessage{
+								Op:   FrameCatchUp,
+								Data: info,
+							})
+						}
+					}
 				} else {
-					c.SendError(JOIN_ROOM_ERROR, message.Op, err.Error())
+					c.ReplyError(message, JOIN_ROOM_ERROR, err.Error())
 				}
 			}
 		case ExitRoom:
 			if c.room != nil {
 				c.room.ExitClient(c)
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: ExitRoom,
 				})
 			} else {
-				c.SendError(EXIT_ROOM_ERROR, message.Op, "退出房间失败")
+				c.ReplyError(message, EXIT_ROOM_ERROR, "退出房间失败")
 			}
 		case StartFrameSync:
 			// 开始帧同步
 			if c.room != nil {
-				c.room.StartFrameSync()
-				c.SendToUserOp(&ClientMessage{
+				// 可指定帧同步模式（自由下发或锁步），不传时使用房间配置
+				option, ok := decodeRequest[FrameSyncOption](c, message)
+				if !ok {
+					return
+				}
+				c.room.StartFrameSync(option)
+				c.ReplyOp(message, &ClientMessage{
 					Op: StartFrameSync,
 				})
 			} else {
-				c.SendError(START_FRAME_SYNC_ERROR, message.Op, "房间不存在，无法启动帧同步")
+				c.ReplyError(message, START_FRAME_SYNC_ERROR, "房间不存在，无法启动帧同步")
 			}
 		case StopFrameSync:
 			// 开始停止帧同步
 			if c.room != nil {
 				c.room.StopFrameSync(false)
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: StopFrameSync,
 				})
 			} else {
-				c.SendError(STOP_FRAME_SYNC_ERROR, message.Op, "房间不存在，无法停止帧同步")
+				c.ReplyError(message, STOP_FRAME_SYNC_ERROR, "房间不存在，无法停止帧同步")
 			}
 		case StopFrameSyncWithoutUnlock:
 			if c.room != nil {
 				c.room.StopFrameSync(true)
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: StopFrameSyncWithoutUnlock,
 				})
 			} else {
-				c.SendError(STOP_FRAME_SYNC_ERROR, message.Op, "房间不存在，无法停止帧同步")
+				c.ReplyError(message, STOP_FRAME_SYNC_ERROR, "房间不存在，无法停止帧同步")
 			}
 		case UploadFrame:
 			if c.room != nil && c.room.frameSync {
-				// 缓存到用户数据中
-				fdata := FrameData{
-					Time: 0,
-					Data: message.Data,
+				// 检查操作大小并交给房间的校验器处理
+				data, err := c.room.checkInput(c, message.Data)
+				if err != nil {
+					c.ReplyError(message, UPLOAD_FRAME_ERROR, err.Error())
+					return
+				}
+				if l := c.room.lockstep; l != nil {
+					// 锁步模式：每次提交对应一帧，回复该操作生效的帧序号
+					if err := c.room.checkLockstepInput(c, l, message.Tick); err != nil {
+						c.ReplyError(message, UPLOAD_FRAME_ERROR, err.Error())
+						return
+					}
+					tick, err := l.push(c.uid, data)
+					if err != nil {
+						c.ReplyError(message, UPLOAD_FRAME_ERROR, err.Error())
+						return
+					}
+					// 等待玩家操作的帧可以立即下发
+					frameScheduler.wakeUp(c.room.ticker)
+					if !c.noAck {
+						c.ReplyOp(message, &ClientMessage{
+							Op:   UploadFrame,
+							Data: map[string]any{"t": tick},
+						})
+					}
+					return
+				}
+				// 缓存到用户数据中，按期望的帧（message.tick）生效
+				tick, err := c.room.queueInput(c, data, message.Tick)
+				if err != nil {
+					c.ReplyError(message, UPLOAD_FRAME_ERROR, err.Error())
+					return
 				}
-				c.frames.Push(fdata)
-				c.SendToUserOp(&ClientMessage{
-					Op: UploadFrame,
+				if !c.noAck {
+					c.ReplyOp(message, &ClientMessage{
+						Op:   UploadFrame,
+						Data: map[string]any{"t": tick},
+					})
+				}
+			} else {
+				c.ReplyError(message, UPLOAD_FRAME_ERROR, "上传帧同步数据错误")
+			}
+		case FrameCatchUp:
+			// 追帧：按分片下发缺失的帧数据，追上后恢复下发FData
+			if c.room == nil {
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
+				return
+			}
+			req, ok := decodeRequest[FrameCatchUpRequest](c, message)
+			if !ok {
+				return
+			}
+			info, err := c.startCatchUp(req.From)
+			if err != nil {
+				c.ReplyError(message, OP_ERROR, err.Error())
+				return
+			}
+			c.ReplyOp(message, &ClientMessage{
+				Op:   FrameCatchUp,
+				Data: info,
+			})
+		case PlayReplay:
+			// 播放回放：按原始帧率以FData下发回放中的帧
+			req, ok := decodeRequest[PlayReplayRequest](c, message)
+			if !ok {
+				return
+			}
+			meta, err := c.playReplay(req.Id, req.Speed, req.From)
+			if err != nil {
+				c.ReplyError(message, OP_ERROR, err.Error())
+				return
+			}
+			c.ReplyOp(message, &ClientMessage{
+				Op:   PlayReplay,
+				Data: meta,
+			})
+		case ReplayControl:
+			// 控制回放：暂停、继续、跳转、变速、停止
+			req, ok := decodeRequest[ReplayControlRequest](c, message)
+			if !ok {
+				return
+			}
+			if req.Action == "stop" {
+				p := c.playback
+				if p == nil {
+					c.ReplyError(message, OP_ERROR, "没有正在播放的回放")
+					return
+				}
+				c.stopPlayback()
+				c.ReplyOp(message, &ClientMessage{
+					Op:   ReplayControl,
+					Data: p.state(PlaybackStopped),
+				})
+				return
+			}
+			state, err := c.controlPlayback(req.Action, req.T, req.Speed)
+			if err != nil {
+				c.ReplyError(message, OP_ERROR, err.Error())
+				return
+			}
+			c.ReplyOp(message, &ClientMessage{
+				Op:   ReplayControl,
+				Data: state,
+			})
+		case TimeSync:
+			// 时间同步：返回服务器时间与当前帧
+			req, ok := decodeRequest[TimeSyncRequest](c, message)
+			if !ok {
+				return
+			}
+			c.ReplyOp(message, &ClientMessage{
+				Op:   TimeSync,
+				Data: c.timeSync(req.C),
+			})
+		case ChangeFrameRate:
+			// 修改帧同步中的帧率，生效的帧序号会通知所有成员
+			if c.room == nil {
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
+				return
+			}
+			if c.room.master != c {
+				c.ReplyError(message, ROOM_PERMISSION_DENIED, "需要房主操作")
+				return
+			}
+			req, ok := decodeRequest[ChangeFrameRateRequest](c, message)
+			if !ok {
+				return
+			}
+			event, err := c.room.ChangeFrameRate(req.FPS, req.At)
+			if err != nil {
+				c.ReplyError(message, OP_ERROR, err.Error())
+				return
+			}
+			c.ReplyOp(message, &ClientMessage{
+				Op:   ChangeFrameRate,
+				Data: event,
+			})
+		case Pong:
+			// 心跳回复，只更新往返延迟
+			req, ok := decodeRequest[PongRequest](c, message)
+			if !ok {
+				return
+			}
+			c.onPong(req.S)
+		case ReportChecksum:
+			// 上报帧校验值，用于检测客户端之间的状态不同步
+			if c.room != nil && c.room.frameSync {
+				req, ok := decodeRequest[ReportChecksumRequest](c, message)
+				if !ok {
+					return
+				}
+				if err := c.room.reportChecksum(c, req.T, req.Checksum); err != nil {
+					c.ReplyError(message, DATA_ERROR, err.Error())
+					return
+				}
+				c.ReplyOp(message, &ClientMessage{
+					Op: ReportChecksum,
 				})
 			} else {
-				c.SendError(UPLOAD_FRAME_ERROR, message.Op, "上传帧同步数据错误")
+				c.ReplyError(message, OP_ERROR, "帧同步未开启，无法上报校验值")
 			}
 		case SendToUser:
 			// 仅给某个玩家转发某些信息
-			id := util.GetMapValueToInt(message.Data, "uid")
-			data := util.GetMapValueToAny(message.Data, "data")
-			user := c.getApp().usersSQL.GetUserDataByUid(id)
+			req, ok := decodeRequest[SendToUserRequest](c, message)
+			if !ok {
+				return
+			}
+			user := c.getApp().usersSQL.GetUserDataByUid(req.Uid)
 			if user != nil && user.client != nil {
 				user.client.SendToUserOp(&ClientMessage{
 					Op: UserMessage,
 					Data: map[string]any{
 						"uid":  c.uid,
-						"data": data,
+						"data": req.Data,
 					},
 				})
 			} else {
-				c.SendError(OP_ERROR, message.Op, "当前用户不在线")
+				c.ReplyError(message, OP_ERROR, "当前用户不在线")
 			}
 		case RoomMessage:
 			// 转发房间信息
@@ -271,7 +502,7 @@ func (c *Client) OnMessage(data []byte) {
 						c.room.userStateLock.Unlock()
 
 						// 发送同步状态给请求者
-						c.SendToUserOp(&ClientMessage{
+						c.ReplyOp(message, &ClientMessage{
 							Op:   RoomMessage,
 							Data: response,
 						})
@@ -285,7 +516,7 @@ func (c *Client) OnMessage(data []byte) {
 								"data": message.Data,
 							},
 						}, c)
-						c.SendToUserOp(&ClientMessage{
+						c.ReplyOp(message, &ClientMessage{
 							Op: RoomMessage,
 						})
 						return
@@ -300,56 +531,49 @@ func (c *Client) OnMessage(data []byte) {
 						"data": message.Data,
 					},
 				}, c)
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: RoomMessage,
 				})
 			} else {
-				c.SendError(SEND_ROOM_ERROR, message.Op, "房间不存在")
+				c.ReplyError(message, SEND_ROOM_ERROR, "房间不存在")
 			}
 		case CannelMatchUser:
 			// 取消匹配用户
 			c.getApp().matchs.cannelMatchUser(c)
-			c.SendToUserOp(&ClientMessage{
+			c.ReplyOp(message, &ClientMessage{
 				Op: CannelMatchUser,
 			})
 		case MatchUser:
 			if c.room != nil {
-				c.SendError(MATCH_ERROR, message.Op, "已在房间中，无法匹配")
+				c.ReplyError(message, MATCH_ERROR, "已在房间中，无法匹配")
 				return
 			}
 			// 匹配用户
-			num := util.GetMapValueToInt(message.Data, "number")
-			if num <= 1 {
-				c.SendError(MATCH_ERROR, message.Op, "提供的number参数必须大于2")
+			option, ok := decodeRequest[MatchOption](c, message)
+			if !ok {
 				return
 			}
-			option := &MatchOption{}
-			b2 := util.SetJsonTo(&message.Data, option)
-			if b2 {
-				fmt.Println("匹配参数", option)
-				b := c.getApp().matchs.matchUser(c, option)
-				if !b {
-					c.SendError(MATCH_ERROR, message.Op, "已在匹配列表中")
-				} else {
-					c.SendToUserOp(&ClientMessage{
-						Op: MatchUser,
-					})
-				}
+			if option.Number <= 1 {
+				c.ReplyError(message, MATCH_ERROR, "提供的number参数必须大于2")
+				return
+			}
+			fmt.Println("匹配参数", option)
+			b := c.getApp().matchs.matchUser(c, option)
+			if !b {
+				c.ReplyError(message, MATCH_ERROR, "已在匹配列表中")
 			} else {
-				c.SendError(MATCH_ERROR, message.Op, "匹配参数错误")
+				c.ReplyOp(message, &ClientMessage{
+					Op: MatchUser,
+				})
 			}
 		case UpdateUserData:
 			// 更新用户信息
-			obj, bool := message.Data.(map[string]any)
-			if bool {
-				keys := make([]string, 0, len(obj))
-				for k := range obj {
-					keys = append(keys, k)
+			obj, ok := decodeRequest[map[string]any](c, message)
+			if ok {
+				for k, v := range *obj {
+					c.userData.Store(k, v)
 				}
-				for _, v := range keys {
-					c.userData.Store(v, obj[v])
-				}
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: UpdateUserData,
 				})
 				// 如果存在房间时，应该同步到房间中的每个人
@@ -362,144 +586,144 @@ func (c *Client) OnMessage(data []byte) {
 						},
 					}, c)
 				}
-			} else {
-				c.SendError(UPDATE_USER_ERROR, message.Op, "无效的操作数据")
 			}
 		case GetRoomOldMessage:
 			// 获取房间的历史消息记录
 			if c.room != nil {
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op:   GetRoomOldMessage,
 					Data: c.room.oldMsgs,
 				})
 			} else {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			}
 		case LockRoom:
 			// 更新房间自定义信息，房主操作
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
 				if c.room.master == c {
 					c.room.lock = true
 					c.getApp().broadcastRoomListChanged()
-					c.SendToUserOp(&ClientMessage{
+					c.ReplyOp(message, &ClientMessage{
 						Op: LockRoom,
 					})
 				} else {
-					c.SendError(ROOM_PERMISSION_DENIED, message.Op, "需要房主操作")
+					c.ReplyError(message, ROOM_PERMISSION_DENIED, "需要房主操作")
 				}
 			}
 		case UnlockRoom:
 			// 更新房间自定义信息，房主操作
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
 				if c.room.master == c {
 					c.room.lock = false
 					c.getApp().broadcastRoomListChanged()
 					c.room.cleanZombieClients()
-					c.SendToUserOp(&ClientMessage{
+					c.ReplyOp(message, &ClientMessage{
 						Op: LockRoom,
 					})
 				} else {
-					c.SendError(ROOM_PERMISSION_DENIED, message.Op, "需要房主操作")
+					c.ReplyError(message, ROOM_PERMISSION_DENIED, "需要房主操作")
 				}
 			}
 		case UpdateRoomCustomData:
 			// 更新房间自定义信息，房主操作
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
 				if c.room.master == c {
+					if _, ok := decodeRequest[map[string]any](c, message); !ok {
+						return
+					}
 					c.room.updateCustomData(message.Data)
-					c.SendToUserOp(&ClientMessage{
+					c.ReplyOp(message, &ClientMessage{
 						Op: UpdateRoomCustomData,
 					})
 					c.room.onRoomChanged()
 					c.getApp().broadcastRoomListChanged()
 				} else {
-					c.SendError(ROOM_PERMISSION_DENIED, message.Op, "需要房主操作")
+					c.ReplyError(message, ROOM_PERMISSION_DENIED, "需要房主操作")
 				}
 			}
 		case UpdateRoomOption:
 			// 更新房间的固定信息，人数、密码等
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
 				if c.room.master == c {
-					m, b := message.Data.(map[string]any)
-					if b {
-						c.room.updateRoomData(RoomConfigOption{
-							maxCounts: util.GetMapValueToInt(m, "maxCounts"),
-							password:  util.GetMapValueToString(m, "password"),
-						})
-						c.SendToUserOp(&ClientMessage{
+					req, ok := decodeRequest[UpdateRoomOptionRequest](c, message)
+					if ok {
+						option := RoomConfigOption{
+							maxCounts:      req.MaxCounts,
+							password:       req.Password,
+							maxSpectators:  c.room.option.maxSpectators,
+							spectatorDelay: c.room.option.spectatorDelay,
+						}
+						if req.MaxSpectators != nil {
+							option.maxSpectators = *req.MaxSpectators
+						}
+						if req.SpectatorDelay != nil {
+							option.spectatorDelay = *req.SpectatorDelay
+						}
+						c.room.updateRoomData(option)
+						c.ReplyOp(message, &ClientMessage{
 							Op: UpdateRoomOption,
 						})
 						c.room.onRoomChanged()
 						c.getApp().broadcastRoomListChanged()
-					} else {
-						c.SendError(DATA_ERROR, message.Op, "数据结构错误")
 					}
 				} else {
-					c.SendError(ROOM_PERMISSION_DENIED, message.Op, "需要房主操作")
+					c.ReplyError(message, ROOM_PERMISSION_DENIED, "需要房主操作")
 				}
 			}
 		case KickOut:
 			// 踢人流程
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
 				if c.room.master == c {
-					m, b := message.Data.(map[string]any)
-					if b {
-						uid := util.GetMapValueToInt(m, "uid")
-						if uid == c.room.master.uid {
-							c.SendError(ROOM_PERMISSION_DENIED, message.Op, "无法踢出房主")
+					req, ok := decodeRequest[UidRequest](c, message)
+					if ok {
+						if req.Uid == c.room.master.uid {
+							c.ReplyError(message, ROOM_PERMISSION_DENIED, "无法踢出房主")
 						} else {
-							c.room.kickOut(uid)
+							c.room.kickOut(req.Uid)
 						}
-					} else {
-						c.SendError(DATA_ERROR, message.Op, "数据结构错误")
 					}
 				} else {
-					c.SendError(ROOM_PERMISSION_DENIED, message.Op, "需要房主操作")
+					c.ReplyError(message, ROOM_PERMISSION_DENIED, "需要房主操作")
 				}
 			}
 		case GetFrameAt:
 			// 获取范围帧数据 Bate
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
-				m, b := message.Data.(map[string]any)
-				if b {
-					start := util.GetMapValueToInt(m, "start")
-					end := util.GetMapValueToInt(m, "end")
-					if end == 0 {
-						end = c.room.frameDatas.Length()
+				req, ok := decodeRequest[GetFrameAtRequest](c, message)
+				if ok {
+					frames, err := c.room.getFrames(c, req.Start, req.End)
+					if err != nil {
+						c.ReplyError(message, DATA_ERROR, err.Error())
+						return
 					}
-					c.SendToUserOp(&ClientMessage{
+					c.ReplyOp(message, &ClientMessage{
 						Op:   GetFrameAt,
-						Data: c.room.frameDatas.List[start:end],
+						Data: frames,
 					})
-				} else {
-					c.SendError(DATA_ERROR, message.Op, "数据结构错误")
 				}
 			}
 		case SetRoomState:
 			// 同步房间状态
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
-				m, b := message.Data.(map[string]any)
-				if b {
-					keys := make([]string, 0, len(m))
-					for k := range m {
-						keys = append(keys, k)
-					}
-					for _, v := range keys {
-						c.room.roomState.Data.Store(v, m[v])
+				req, ok := decodeRequest[map[string]any](c, message)
+				if ok {
+					m := *req
+					for k, v := range m {
+						c.room.roomState.Data.Store(k, v)
 					}
 					// 需要把更改数据下发给其他的所有人
 					c.room.SendToAllUserOp(&ClientMessage{
@@ -507,23 +731,18 @@ func (c *Client) OnMessage(data []byte) {
 						Data: m,
 					}, c)
 					// 通知更改成功
-					c.SendToUserOp(&ClientMessage{
+					c.ReplyOp(message, &ClientMessage{
 						Op: SetRoomState,
 					})
-				} else {
-					c.SendError(DATA_ERROR, message.Op, "数据结构错误")
 				}
 			}
 		case SetClientState:
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
-				m, b := message.Data.(map[string]any)
-				if b {
-					keys := make([]string, 0, len(m))
-					for k := range m {
-						keys = append(keys, k)
-					}
+				req, ok := decodeRequest[map[string]any](c, message)
+				if ok {
+					m := *req
 					c.room.userStateLock.Lock()
 					defer c.room.userStateLock.Unlock()
 					u, e := c.room.userState[c.uid]
@@ -532,8 +751,8 @@ func (c *Client) OnMessage(data []byte) {
 							Data: util.CreateMap(),
 						}
 					}
-					for _, v := range keys {
-						u.Data.Store(v, m[v])
+					for k, v := range m {
+						u.Data.Store(k, v)
 					}
 					c.room.userState[c.uid] = u
 					// 需要把更改数据下发给其他的所有人
@@ -545,47 +764,44 @@ func (c *Client) OnMessage(data []byte) {
 						},
 					}, c)
 					// 通知更改成功
-					c.SendToUserOp(&ClientMessage{
+					c.ReplyOp(message, &ClientMessage{
 						Op: SetClientState,
 					})
-				} else {
-					c.SendError(DATA_ERROR, message.Op, "数据结构错误")
 				}
 			}
 		case ResetRoom:
 			// 重置房间状态
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
 				// 停止帧同步，同时清空帧缓存
 				c.room.StopFrameSync(false)
-				c.room.frameDatas = util.CreateArray()
+				c.room.resetFrameHistory()
 				// 重置房间状态
 				c.room.roomState = &ClientState{
 					Data: util.CreateMap(),
 				}
 				// 重置用户状态
 				c.room.userState = map[int]*ClientState{}
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: ResetRoom,
 				})
 			}
 		case SetRoomMatchOption:
 			// 设置匹配参数
 			if c.room == nil {
-				c.SendError(ROOM_NOT_EXSIT, message.Op, "房间不存在")
+				c.ReplyError(message, ROOM_NOT_EXSIT, "房间不存在")
 			} else {
 				if c.room.master == c {
-					b := util.SetJsonTo(message.Data, c.room.matchOption)
-					if b {
-						c.SendToUserOp(&ClientMessage{
+					option, ok := decodeRequest[MatchOption](c, message)
+					if ok {
+						c.room.matchOption = option
+						c.ReplyOp(message, &ClientMessage{
 							Op: SetRoomMatchOption,
 						})
-					} else {
-						c.SendError(DATA_ERROR, message.Op, "数据结构错误")
 					}
 				} else {
-					c.SendError(ROOM_PERMISSION_DENIED, message.Op, "需要房主操作")
+					c.ReplyError(message, ROOM_PERMISSION_DENIED, "需要房主操作")
 				}
 			}
 		case MatchRoom:
@@ -595,13 +811,15 @@ func (c *Client) OnMessage(data []byte) {
 				logs.InfoM("match room fail, exsits room.", c.name)
 				c.room.ExitClient(c)
 			}
-			matchOption := &MatchOption{}
-			util.SetJsonTo(message.Data, matchOption)
+			matchOption, ok := decodeRequest[MatchOption](c, message)
+			if !ok {
+				return
+			}
 			c.matchOption = matchOption
 			r, err := c.getApp().MatchRoom(c)
 			if err == nil {
 				logs.InfoM("match room success", c.name)
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: MatchRoom,
 					Data: map[string]any{
 						"id": r.id,
@@ -610,10 +828,10 @@ func (c *Client) OnMessage(data []byte) {
 			} else {
 				// 当不存在匹配房间时，如果是自动创建房间时，则开始读取
 				logs.InfoM("match room success, create new room", c.name)
-				r2 := c.getApp().CreateRoom(c, RoomConfigOption{maxCounts: matchOption.Number, password: "", fps: matchOption.FPS})
+				r2 := c.getApp().CreateRoom(c, RoomConfigOption{maxCounts: matchOption.Number, password: "", fps: matchOption.FPS, maxSpectators: defaultSpectatorCounts, spectatorDelay: defaultSpectatorDelay})
 				r2.matchOption = matchOption
 				r2.JoinClient(c)
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: MatchRoom,
 					Data: map[string]any{
 						"id": r2.id,
@@ -623,11 +841,13 @@ func (c *Client) OnMessage(data []byte) {
 			c.matchOption = nil
 		case GetRoomList:
 			// 获取房间列表
-			page := util.GetMapValueToInt(message.Data, "page")
-			counts := util.GetMapValueToInt(message.Data, "counts")
-			data := c.getApp().GetRoomList(page, counts)
+			req, ok := decodeRequest[GetRoomListRequest](c, message)
+			if !ok {
+				return
+			}
+			data := c.getApp().GetRoomList(req.Page, req.Counts)
 			if data != nil {
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: GetRoomList,
 					Data: map[string]any{
 						"onlineCounts": c.getApp().users.Length(),
@@ -635,7 +855,7 @@ func (c *Client) OnMessage(data []byte) {
 					},
 				})
 			} else {
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: GetRoomList,
 					Data: map[string]any{
 						"onlineCounts": c.getApp().users.Length(),
@@ -646,35 +866,47 @@ func (c *Client) OnMessage(data []byte) {
 		case SendServerMsg:
 			// 发送全服消息
 			c.getApp().SendServerMsg(c, message)
-			c.SendToUserOp(&ClientMessage{
+			c.ReplyOp(message, &ClientMessage{
 				Op: SendServerMsg,
 			})
 		case ListenerServer:
 			// 统一侦听服务器通知（data中可指定op，默认为EVENT_GetServerMsg）
-			targetOp := ClientAction(util.GetMapValueToInt(message.Data, "op"))
+			req, ok := decodeRequest[ListenerRequest](c, message)
+			if !ok {
+				return
+			}
+			targetOp := req.Op
 			if targetOp == 0 {
 				targetOp = EVENT_GetServerMsg
 			}
 			c.getApp().addListener(c, targetOp)
-			c.SendToUserOp(&ClientMessage{
+			c.ReplyOp(message, &ClientMessage{
 				Op: ListenerServer,
 			})
 		case CannelListenerServer:
 			// 统一取消侦听服务器通知（data中可指定op，默认为EVENT_GetServerMsg）
-			targetOp := ClientAction(util.GetMapValueToInt(message.Data, "op"))
+			req, ok := decodeRequest[ListenerRequest](c, message)
+			if !ok {
+				return
+			}
+			targetOp := req.Op
 			if targetOp == 0 {
 				targetOp = EVENT_GetServerMsg
 			}
 			c.getApp().removeListener(c, targetOp)
-			c.SendToUserOp(&ClientMessage{
+			c.ReplyOp(message, &ClientMessage{
 				Op: CannelListenerServer,
 			})
 		case GetUserDataByUID:
 			// 通过UID获取用户数据
-			uid := util.GetMapValueToInt(message.Data, "uid")
+			req, ok := decodeRequest[UidRequest](c, message)
+			if !ok {
+				return
+			}
+			uid := req.Uid
 			userdata := c.getApp().usersSQL.GetUserDataByUid(uid)
 			if userdata != nil {
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: message.Op,
 					Data: map[string]any{
 						"data": userdata.client.userData,
@@ -683,47 +915,45 @@ func (c *Client) OnMessage(data []byte) {
 					},
 				})
 			} else {
-				c.SendError(OP_ERROR, message.Op, "用户数据无法获取")
+				c.ReplyError(message, OP_ERROR, "用户数据无法获取")
 			}
 		case GetServerOldMsg:
 			// 获取历史全服消息
-			counts := util.GetMapValueToInt(message.Data, "counts")
-			if counts > 0 {
-				c.getApp().GetServerMsg(c, counts)
-				c.SendToUserOp(&ClientMessage{
+			req, ok := decodeRequest[GetServerOldMsgRequest](c, message)
+			if ok {
+				c.getApp().GetServerMsg(c, req.Counts)
+				c.ReplyOp(message, &ClientMessage{
 					Op: GetServerOldMsg,
 				})
-			} else {
-				c.SendError(OP_ERROR, message.Op, "无效的counts数量")
 			}
 		case ExtendsCall:
 			// 扩展方法调用
-			mName := util.GetMapValueToString(message.Data, "f")
-			api, b := CurrentServer.ExtendsApi[mName]
+			req, ok := decodeRequest[ExtendsCallRequest](c, message)
+			if !ok {
+				return
+			}
+			api, b := CurrentServer.ExtendsApi[req.F]
 			if b {
-				api.Call(c, message, util.GetMapValueToAny(message.Data, "d"))
+				api.Call(c, message, req.D)
 			} else {
-				c.SendError(OP_ERROR, message.Op, "无效扩展方法")
+				c.ReplyError(message, OP_ERROR, "无效扩展方法")
 			}
 		case QueryRoomList:
-			roomidsRaw := util.GetMapValueToAny(message.Data, "roomids")
-			roomids, ok := roomidsRaw.([]any)
-			if ok && roomids != nil {
-				roomInfo := c.getApp().GetQueryRoomList(roomids)
+			req, ok := decodeRequest[QueryRoomListRequest](c, message)
+			if ok {
+				roomInfo := c.getApp().GetQueryRoomList(req.RoomIds)
 				if roomInfo == nil {
 					roomInfo = []any{}
 				}
-				c.SendToUserOp(&ClientMessage{
+				c.ReplyOp(message, &ClientMessage{
 					Op: QueryRoomList,
 					Data: map[string]any{
 						"list": roomInfo,
 					},
 				})
-			} else {
-				c.SendError(OP_ERROR, message.Op, "roomids参数错误")
 			}
 		default:
-			c.SendError(OP_ERROR, message.Op, "无效的操作指令："+fmt.Sprint(message.Op))
+			c.ReplyError(message, OP_ERROR, "无效的操作指令："+fmt.Sprint(message.Op))
 		}
 	} else {
 		fmt.Println("处理命令失败", string(data), err.Error())
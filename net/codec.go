@@ -0,0 +1,190 @@
+package net
+
+import (
+	"fmt"
+	"strconv"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/ugorji/go/codec"
+)
+
+// 消息编解码器，每个连接在握手时通过WebSocket子协议协商，未协商时使用JSON
+type Codec interface {
+	Name() string                                    // 编解码器名称（即WebSocket子协议名称）
+	Binary() bool                                    // 是否为二进制格式（决定使用BinaryMessage还是TextMessage发送）
+	Marshal(msg *ClientMessage) ([]byte, error)      // 编码消息
+	Unmarshal(data []byte, msg *ClientMessage) error // 解码消息，解码后的Data与JSON解析的数据结构保持一致
+	WithSeq(data []byte, seq int) []byte             // 在已编码（不含seq）的消息中写入序号，用于广播消息只编码一次
+	Batch(messages [][]byte) []byte                  // 将多条已编码的消息合并为一条Batch消息
+}
+
+// 已注册的编解码器
+var codecs = map[string]Codec{}
+
+// 子协议优先级列表，协商时按该顺序选择客户端支持的第一个
+var subprotocols = []string{}
+
+func init() {
+	RegisterCodec(&MsgpackCodec{})
+	RegisterCodec(&ProtobufCodec{})
+	RegisterCodec(&JsonCodec{})
+}
+
+// 注册编解码器，注册后可通过子协议名称协商使用
+func RegisterCodec(c Codec) {
+	if _, ok := codecs[c.Name()]; !ok {
+		subprotocols = append(subprotocols, c.Name())
+	}
+	codecs[c.Name()] = c
+	upgrader.Subprotocols = subprotocols
+}
+
+// 获取编解码器，不存在时返回默认的JSON编解码器
+func getCodec(name string) Codec {
+	c, ok := codecs[name]
+	if ok {
+		return c
+	}
+	return codecs["json"]
+}
+
+// JSON编解码器（默认）
+type JsonCodec struct{}
+
+func (j *JsonCodec) Name() string {
+	return "json"
+}
+
+func (j *JsonCodec) Binary() bool {
+	return false
+}
+
+func (j *JsonCodec) Marshal(msg *ClientMessage) ([]byte, error) {
+	return jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(msg)
+}
+
+func (j *JsonCodec) Unmarshal(data []byte, msg *ClientMessage) error {
+	err := jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal(data, msg)
+	// 如果无法以JSON解析时，则使用二进制解析，第一位是op操作符，剩余的是JSON内容
+	if err != nil && len(data) > 0 {
+		msg.Op = ClientAction(data[0])
+		err = jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal(data[1:], &msg.Data)
+	}
+	return err
+}
+
+// 写入序号：{"seq":N, + 原消息剩余部分
+func (j *JsonCodec) WithSeq(data []byte, seq int) []byte {
+	if len(data) < 2 || data[0] != '{' {
+		return data
+	}
+	prefix := `{"seq":` + strconv.Itoa(seq) + `,`
+	v := make([]byte, 0, len(prefix)+len(data)-1)
+	v = append(v, prefix...)
+	return append(v, data[1:]...)
+}
+
+// 合并消息：{"op":52,"data":[消息1,消息2,...]}
+func (j *JsonCodec) Batch(messages [][]byte) []byte {
+	list := make([]jsoniter.RawMessage, len(messages))
+	for i, m := range messages {
+		list[i] = m
+	}
+	v, _ := j.Marshal(&ClientMessage{Op: Batch, Data: list})
+	return v
+}
+
+// MessagePack编解码器，字段名与JSON保持一致
+type MsgpackCodec struct{}
+
+var msgpackHandle = func() *codec.MsgpackHandle {
+	h := &codec.MsgpackHandle{}
+	h.WriteExt = true
+	h.RawToString = true
+	// 允许写入已编码的消息（Batch合并消息）
+	h.Raw = true
+	return h
+}()
+
+func (m *MsgpackCodec) Name() string {
+	return "msgpack"
+}
+
+func (m *MsgpackCodec) Binary() bool {
+	return true
+}
+
+func (m *MsgpackCodec) Marshal(msg *ClientMessage) ([]byte, error) {
+	var data []byte
+	err := codec.NewEncoderBytes(&data, msgpackHandle).Encode(msg)
+	return data, err
+}
+
+// 写入序号：消息编码为fixmap时，直接增加map的字段数并插入seq字段，否则重新编码
+func (m *MsgpackCodec) WithSeq(data []byte, seq int) []byte {
+	if len(data) > 0 && data[0]&0xf0 == 0x80 && data[0] < 0x8f {
+		var field []byte
+		codec.NewEncoderBytes(&field, msgpackHandle).Encode(map[string]int{"seq": seq})
+		v := make([]byte, 0, len(data)+len(field)-1)
+		v = append(v, data[0]+1)
+		v = append(v, field[1:]...)
+		return append(v, data[1:]...)
+	}
+	msg := &ClientMessage{}
+	if m.Unmarshal(data, msg) != nil {
+		return data
+	}
+	msg.Seq = seq
+	v, _ := m.Marshal(msg)
+	return v
+}
+
+// 合并消息：{op: 52, data: [消息1, 消息2, ...]}
+func (m *MsgpackCodec) Batch(messages [][]byte) []byte {
+	list := make([]codec.Raw, len(messages))
+	for i, item := range messages {
+		list[i] = item
+	}
+	v, _ := m.Marshal(&ClientMessage{Op: Batch, Data: list})
+	return v
+}
+
+func (m *MsgpackCodec) Unmarshal(data []byte, msg *ClientMessage) error {
+	err := codec.NewDecoderBytes(data, msgpackHandle).Decode(msg)
+	if err == nil {
+		msg.Data = normalizeData(msg.Data)
+	}
+	return err
+}
+
+// 将二进制格式解码出的数据转换为与JSON解析一致的结构（数字统一为float64，Map统一为map[string]any），
+// 保证操作处理逻辑不需要区分编解码器
+func normalizeData(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			val[k] = normalizeData(item)
+		}
+		return val
+	case map[any]any:
+		m := make(map[string]any, len(val))
+		for k, item := range val {
+			m[fmt.Sprint(k)] = normalizeData(item)
+		}
+		return m
+	case []any:
+		for i, item := range val {
+			val[i] = normalizeData(item)
+		}
+		return val
+	case int64:
+		return float64(val)
+	case uint64:
+		return float64(val)
+	case int:
+		return float64(val)
+	case float32:
+		return float64(val)
+	}
+	return v
+}
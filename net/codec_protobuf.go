@@ -0,0 +1,189 @@
+package net
+
+import (
+	"websocket_server/pb"
+
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Protobuf编解码器，消息定义见 pb/hxonline.proto
+//
+// 负载消息与JSON结构一一对应，编解码时通过protojson转换，操作处理逻辑拿到的Data与JSON连接完全一致
+type ProtobufCodec struct{}
+
+// 通用负载类型，未声明负载消息的op（如扩展方法）使用该类型
+var anyPayload = (&structpb.Value{}).ProtoReflect().Type()
+
+// 客户端请求的负载消息类型
+var requestPayloads = map[ClientAction]protoreflect.MessageType{
+	Login:                (&pb.LoginRequest{}).ProtoReflect().Type(),
+	ResumeSession:        (&pb.ResumeSessionRequest{}).ProtoReflect().Type(),
+	CreateRoom:           (&pb.CreateRoomRequest{}).ProtoReflect().Type(),
+	StartFrameSync:       (&pb.FrameSyncOption{}).ProtoReflect().Type(),
+	JoinRoom:             (&pb.JoinRoomRequest{}).ProtoReflect().Type(),
+	SwitchSeat:           (&pb.SwitchSeatRequest{}).ProtoReflect().Type(),
+	UploadFrame:          anyPayload,
+	RoomMessage:          anyPayload,
+	SendToUser:           (&pb.SendToUserRequest{}).ProtoReflect().Type(),
+	MatchUser:            (&pb.MatchOption{}).ProtoReflect().Type(),
+	MatchRoom:            (&pb.MatchOption{}).ProtoReflect().Type(),
+	SetRoomMatchOption:   (&pb.MatchOption{}).ProtoReflect().Type(),
+	UpdateUserData:       (&structpb.Struct{}).ProtoReflect().Type(),
+	UpdateRoomCustomData: (&structpb.Struct{}).ProtoReflect().Type(),
+	SetRoomState:         (&structpb.Struct{}).ProtoReflect().Type(),
+	SetClientState:       (&structpb.Struct{}).ProtoReflect().Type(),
+	UpdateRoomOption:     (&pb.UpdateRoomOptionRequest{}).ProtoReflect().Type(),
+	KickOut:              (&pb.UidRequest{}).ProtoReflect().Type(),
+	GetUserDataByUID:     (&pb.UidRequest{}).ProtoReflect().Type(),
+	GetFrameAt:           (&pb.GetFrameAtRequest{}).ProtoReflect().Type(),
+	GetRoomList:          (&pb.GetRoomListRequest{}).ProtoReflect().Type(),
+	SendServerMsg:        anyPayload,
+	ListenerServer:       (&pb.ListenerRequest{}).ProtoReflect().Type(),
+	CannelListenerServer: (&pb.ListenerRequest{}).ProtoReflect().Type(),
+	GetServerOldMsg:      (&pb.GetServerOldMsgRequest{}).ProtoReflect().Type(),
+	ExtendsCall:          (&pb.ExtendsCallRequest{}).ProtoReflect().Type(),
+	QueryRoomList:        (&pb.QueryRoomListRequest{}).ProtoReflect().Type(),
+	ReportChecksum:       (&pb.ReportChecksumRequest{}).ProtoReflect().Type(),
+	FrameCatchUp:         (&pb.FrameCatchUpRequest{}).ProtoReflect().Type(),
+	PlayReplay:           (&pb.PlayReplayRequest{}).ProtoReflect().Type(),
+	ReplayControl:        (&pb.ReplayControlRequest{}).ProtoReflect().Type(),
+	TimeSync:             (&pb.TimeSyncRequest{}).ProtoReflect().Type(),
+	Pong:                 (&pb.PongRequest{}).ProtoReflect().Type(),
+	ChangeFrameRate:      (&pb.ChangeFrameRateRequest{}).ProtoReflect().Type(),
+}
+
+// 服务器回复与事件的负载消息类型
+var replyPayloads = map[ClientAction]protoreflect.MessageType{
+	Error:                 (&pb.ClientError{}).ProtoReflect().Type(),
+	Login:                 (&pb.LoginReply{}).ProtoReflect().Type(),
+	ResumeSession:         (&pb.LoginReply{}).ProtoReflect().Type(),
+	CreateRoom:            (&pb.RoomId{}).ProtoReflect().Type(),
+	JoinRoom:              (&pb.RoomId{}).ProtoReflect().Type(),
+	MatchRoom:             (&pb.RoomId{}).ProtoReflect().Type(),
+	GetRoomData:           (&pb.RoomData{}).ProtoReflect().Type(),
+	FData:                 (&pb.FrameEvent{}).ProtoReflect().Type(),
+	FrameSyncReady:        (&pb.FrameSyncOption{}).ProtoReflect().Type(),
+	UploadFrame:           (&pb.UploadFrameReply{}).ProtoReflect().Type(),
+	RoomMessage:           anyPayload,
+	JoinRoomClient:        (&pb.UserData{}).ProtoReflect().Type(),
+	ExitRoomClient:        (&pb.UserData{}).ProtoReflect().Type(),
+	OutOnlineRoomClient:   (&pb.UserData{}).ProtoReflect().Type(),
+	UpdateRoomUserData:    (&pb.UserPayload{}).ProtoReflect().Type(),
+	ClientStateUpdate:     (&pb.UserPayload{}).ProtoReflect().Type(),
+	UserMessage:           (&pb.UserPayload{}).ProtoReflect().Type(),
+	EVENT_GetServerMsg:    (&pb.UserPayload{}).ProtoReflect().Type(),
+	RoomStateUpdate:       (&structpb.Struct{}).ProtoReflect().Type(),
+	GetRoomOldMessage:     (&pb.RoomOldMessageReply{}).ProtoReflect().Type(),
+	GetFrameAt:            (&structpb.ListValue{}).ProtoReflect().Type(),
+	GetRoomList:           (&pb.RoomListReply{}).ProtoReflect().Type(),
+	QueryRoomList:         (&pb.QueryRoomListReply{}).ProtoReflect().Type(),
+	GetUserDataByUID:      (&pb.UserDataByUidReply{}).ProtoReflect().Type(),
+	SeatUpdate:            (&pb.SeatUpdateEvent{}).ProtoReflect().Type(),
+	EVENT_RoomListChanged: (&pb.RoomListChangedEvent{}).ProtoReflect().Type(),
+	MessagesDropped:       (&pb.MessagesDroppedEvent{}).ProtoReflect().Type(),
+	Desync:                (&pb.DesyncEvent{}).ProtoReflect().Type(),
+	FrameCatchUp:          (&pb.CatchUpInfo{}).ProtoReflect().Type(),
+	CatchUpChunk:          (&pb.CatchUpChunkEvent{}).ProtoReflect().Type(),
+	PlayReplay:            (&pb.ReplayMeta{}).ProtoReflect().Type(),
+	ReplayControl:         (&pb.ReplayStateEvent{}).ProtoReflect().Type(),
+	ReplayState:           (&pb.ReplayStateEvent{}).ProtoReflect().Type(),
+	TimeSync:              (&pb.TimeSyncReply{}).ProtoReflect().Type(),
+	ChangeFrameRate:       (&pb.FrameRateEvent{}).ProtoReflect().Type(),
+	FrameRateChanged:      (&pb.FrameRateEvent{}).ProtoReflect().Type(),
+	Ping:                  (&pb.PingEvent{}).ProtoReflect().Type(),
+}
+
+// 获取负载消息类型，未声明时使用通用类型
+func payloadType(payloads map[ClientAction]protoreflect.MessageType, op ClientAction) protoreflect.MessageType {
+	t, ok := payloads[op]
+	if ok {
+		return t
+	}
+	return anyPayload
+}
+
+func (p *ProtobufCodec) Name() string {
+	return "protobuf"
+}
+
+func (p *ProtobufCodec) Binary() bool {
+	return true
+}
+
+func (p *ProtobufCodec) Marshal(msg *ClientMessage) ([]byte, error) {
+	env := &pb.Envelope{
+		Op:   int32(msg.Op),
+		Id:   int32(msg.Id),
+		Push: msg.Push,
+		Seq:  int32(msg.Seq),
+		Tick: int32(msg.Tick),
+	}
+	if msg.Data != nil {
+		// 先转为JSON，再按负载消息的JSON映射解析，未定义的字段会被忽略
+		j, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		payload := payloadType(replyPayloads, msg.Op).New().Interface()
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(j, payload)
+		if err != nil {
+			return nil, err
+		}
+		env.Data, err = proto.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return proto.Marshal(env)
+}
+
+// 写入序号：Protobuf字段顺序无关，直接在末尾追加Envelope.seq字段
+func (p *ProtobufCodec) WithSeq(data []byte, seq int) []byte {
+	v := make([]byte, 0, len(data)+6)
+	v = append(v, data...)
+	v = protowire.AppendTag(v, 5, protowire.VarintType)
+	return protowire.AppendVarint(v, uint64(int32(seq)))
+}
+
+// 合并消息：Envelope{op: 52, data: Batch{messages: [消息1, 消息2, ...]}}
+func (p *ProtobufCodec) Batch(messages [][]byte) []byte {
+	data, _ := proto.Marshal(&pb.Batch{Messages: messages})
+	v, _ := proto.Marshal(&pb.Envelope{
+		Op:   int32(Batch),
+		Data: data,
+	})
+	return v
+}
+
+func (p *ProtobufCodec) Unmarshal(data []byte, msg *ClientMessage) error {
+	env := &pb.Envelope{}
+	err := proto.Unmarshal(data, env)
+	if err != nil {
+		return err
+	}
+	msg.Op = ClientAction(env.Op)
+	msg.Id = int(env.Id)
+	msg.Push = env.Push
+	msg.Seq = int(env.Seq)
+	msg.Tick = int(env.Tick)
+	// 所有字段均为零值的负载编码后为空，声明了负载消息的op同样需要解码
+	if _, ok := requestPayloads[msg.Op]; ok || len(env.Data) > 0 {
+		payload := payloadType(requestPayloads, msg.Op).New().Interface()
+		err = proto.Unmarshal(env.Data, payload)
+		if err != nil {
+			return err
+		}
+		// 输出零值字段，与JSON连接一致：seq为0、空列表等不会被当作缺少字段
+		j, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		return jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal(j, &msg.Data)
+	}
+	return nil
+}
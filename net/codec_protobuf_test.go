@@ -0,0 +1,128 @@
+package net
+
+import (
+	"testing"
+	"websocket_server/pb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// 编码Protobuf请求：Envelope{op, data: 负载消息}
+func encodeProtobufRequest(t *testing.T, op ClientAction, payload proto.Message) []byte {
+	t.Helper()
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env, err := proto.Marshal(&pb.Envelope{Op: int32(op), Id: 1, Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return env
+}
+
+// 64位整数在protojson中编码为字符串，解析请求时需要按数字处理
+func TestProtobufInt64Request(t *testing.T) {
+	codec := &ProtobufCodec{}
+	tests := []struct {
+		name    string
+		op      ClientAction
+		payload proto.Message
+		decode  func(data any) (int64, error)
+		want    int64
+	}{
+		{
+			name:    "Pong",
+			op:      Pong,
+			payload: &pb.PongRequest{S: 1234567890123},
+			decode: func(data any) (int64, error) {
+				v := PongRequest{}
+				err := decodeData(data, &v)
+				return v.S, err
+			},
+			want: 1234567890123,
+		},
+		{
+			name:    "TimeSync",
+			op:      TimeSync,
+			payload: &pb.TimeSyncRequest{C: 1700000000000},
+			decode: func(data any) (int64, error) {
+				v := TimeSyncRequest{}
+				err := decodeData(data, &v)
+				return v.C, err
+			},
+			want: 1700000000000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &ClientMessage{}
+			if err := codec.Unmarshal(encodeProtobufRequest(t, tt.op, tt.payload), msg); err != nil {
+				t.Fatal(err)
+			}
+			if msg.Op != tt.op {
+				t.Fatalf("op = %d, want %d", msg.Op, tt.op)
+			}
+			got, err := tt.decode(msg.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// Protobuf不编码零值字段，解码后需要补全，否则seq为0、空列表会被当作缺少字段
+func TestProtobufZeroValueRequest(t *testing.T) {
+	codec := &ProtobufCodec{}
+	tests := []struct {
+		name    string
+		op      ClientAction
+		payload proto.Message
+		decode  func(data any) error
+	}{
+		{
+			name:    "ResumeSession seq 0",
+			op:      ResumeSession,
+			payload: &pb.ResumeSessionRequest{Session: "token", Appid: "t"},
+			decode: func(data any) error {
+				v := ResumeSessionRequest{}
+				if err := decodeData(data, &v); err != nil {
+					return err
+				}
+				if v.Session != "token" || v.Seq != 0 {
+					t.Errorf("got %+v", v)
+				}
+				return nil
+			},
+		},
+		{
+			name:    "QueryRoomList empty roomids",
+			op:      QueryRoomList,
+			payload: &pb.QueryRoomListRequest{},
+			decode: func(data any) error {
+				v := QueryRoomListRequest{}
+				if err := decodeData(data, &v); err != nil {
+					return err
+				}
+				if len(v.RoomIds) != 0 {
+					t.Errorf("got %+v", v)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &ClientMessage{}
+			if err := codec.Unmarshal(encodeProtobufRequest(t, tt.op, tt.payload), msg); err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.decode(msg.Data); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
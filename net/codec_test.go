@@ -0,0 +1,136 @@
+package net
+
+import (
+	"testing"
+	"websocket_server/pb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// 按客户端的方式解析下发的消息，Protobuf下发的负载为回复类型，只解析Envelope
+func decodeSent(t *testing.T, c Codec, data []byte) *ClientMessage {
+	t.Helper()
+	msg := &ClientMessage{}
+	if c.Name() == "protobuf" {
+		env := &pb.Envelope{}
+		if err := proto.Unmarshal(data, env); err != nil {
+			t.Fatal(err)
+		}
+		msg.Op = ClientAction(env.Op)
+		msg.Id = int(env.Id)
+		msg.Push = env.Push
+		msg.Seq = int(env.Seq)
+		msg.Data = env.Data
+		return msg
+	}
+	if err := c.Unmarshal(data, msg); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+// 解析Batch消息中的每条消息
+func decodeBatch(t *testing.T, c Codec, data []byte) []*ClientMessage {
+	t.Helper()
+	msg := decodeSent(t, c, data)
+	if msg.Op != Batch {
+		t.Fatalf("op = %d, want Batch", msg.Op)
+	}
+	list := []*ClientMessage{}
+	if c.Name() == "protobuf" {
+		batch := &pb.Batch{}
+		if err := proto.Unmarshal(msg.Data.([]byte), batch); err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range batch.Messages {
+			list = append(list, decodeSent(t, c, m))
+		}
+		return list
+	}
+	items, ok := msg.Data.([]any)
+	if !ok {
+		t.Fatalf("batch data = %T, want array", msg.Data)
+	}
+	for _, item := range items {
+		m := item.(map[string]any)
+		v := &ClientMessage{Op: ClientAction(m["op"].(float64)), Data: m["data"]}
+		if seq, ok := m["seq"].(float64); ok {
+			v.Seq = int(seq)
+		}
+		if id, ok := m["id"].(float64); ok {
+			v.Id = int(id)
+		}
+		v.Push, _ = m["push"].(bool)
+		list = append(list, v)
+	}
+	return list
+}
+
+var codecTestMessages = []*ClientMessage{
+	{Op: ChangedRoom, Push: true},
+	{Op: ChangedRoom, Id: 7},
+	{Op: FData, Data: map[string]any{"t": 3, "d": map[int][]any{1: {"a"}}}, Push: true},
+}
+
+func TestCodecWithSeq(t *testing.T) {
+	for _, name := range []string{"json", "msgpack", "protobuf"} {
+		c := getCodec(name)
+		for i, message := range codecTestMessages {
+			for _, seq := range []int{1, 127, 128, 65536} {
+				data, err := c.Marshal(message)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := decodeSent(t, c, c.WithSeq(data, seq))
+				if got.Seq != seq || got.Op != message.Op || got.Id != message.Id || got.Push != message.Push {
+					t.Errorf("%s message %d seq %d: got op=%d id=%d push=%v seq=%d", name, i, seq, got.Op, got.Id, got.Push, got.Seq)
+				}
+			}
+		}
+	}
+}
+
+func TestCodecBatch(t *testing.T) {
+	for _, name := range []string{"json", "msgpack", "protobuf"} {
+		c := getCodec(name)
+		encoded := [][]byte{}
+		for i, message := range codecTestMessages {
+			data, err := c.Marshal(message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 合并的消息可以已经写入序号
+			encoded = append(encoded, c.WithSeq(data, i+1))
+		}
+		list := decodeBatch(t, c, c.Batch(encoded))
+		if len(list) != len(codecTestMessages) {
+			t.Fatalf("%s: batch has %d messages, want %d", name, len(list), len(codecTestMessages))
+		}
+		for i, got := range list {
+			want := codecTestMessages[i]
+			if got.Op != want.Op || got.Id != want.Id || got.Push != want.Push || got.Seq != i+1 {
+				t.Errorf("%s message %d: got op=%d id=%d push=%v seq=%d", name, i, got.Op, got.Id, got.Push, got.Seq)
+			}
+		}
+	}
+}
+
+// 广播消息对每个编解码器只编码一次，结果与直接编码一致
+func TestPreparedMessage(t *testing.T) {
+	p := NewPreparedMessage(codecTestMessages[2])
+	for _, name := range []string{"json", "msgpack", "protobuf"} {
+		c := getCodec(name)
+		first, err := p.encode(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, _ := p.encode(c)
+		if &first[0] != &second[0] {
+			t.Errorf("%s: prepared message encoded twice", name)
+		}
+		got := decodeSent(t, c, first)
+		if got.Op != FData || !got.Push {
+			t.Errorf("%s: got op=%d push=%v", name, got.Op, got.Push)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package net
+
+import (
+	"reflect"
+	"sort"
+)
+
+// 紧凑帧数据的参数范围
+const (
+	defaultIdleFold = 8  // 默认最多合并的连续空帧数
+	maxIdleFold     = 60 // 最多合并的连续空帧数上限
+)
+
+// 紧凑帧数据的编码状态（由房间的frameMu保护）。编码结果只依赖展开后的帧数据，
+// 所以开启紧凑模式的用户共用同一份编码结果，追帧结束后也可以直接衔接
+type compactFrames struct {
+	fold int           // 最多合并的连续空帧数
+	idle int           // 尚未下发的连续空帧数
+	prev map[int][]any // 上一帧的操作，用于判断重复
+}
+
+func newCompactFrames(fold int) *compactFrames {
+	return &compactFrames{fold: fold}
+}
+
+// 编码第tick帧，返回nil表示该帧为空帧，等待与之后的帧合并下发。
+// 非空帧会带上之前未下发的空帧（n），与上一帧相同的玩家操作只下发uid（r）
+func (s *compactFrames) next(tick int, frame map[int][]any) *FrameEvent {
+	prev := s.prev
+	s.prev = frame
+	if len(frame) == 0 {
+		s.idle++
+		if s.idle < s.fold {
+			return nil
+		}
+		return s.flush(tick)
+	}
+	event := &FrameEvent{T: tick, D: map[int][]any{}}
+	if s.idle > 0 {
+		event.N = s.idle + 1
+		s.idle = 0
+	}
+	for uid, list := range frame {
+		if old, ok := prev[uid]; ok && reflect.DeepEqual(old, list) {
+			event.R = append(event.R, uid)
+		} else {
+			event.D[uid] = list
+		}
+	}
+	sort.Ints(event.R)
+	return event
+}
+
+// 下发未下发的空帧，没有时返回nil
+func (s *compactFrames) flush(tick int) *FrameEvent {
+	if s.idle == 0 {
+		return nil
+	}
+	event := &FrameEvent{T: tick, D: map[int][]any{}}
+	if s.idle > 1 {
+		event.N = s.idle
+	}
+	s.idle = 0
+	return event
+}
+
+// 给开启紧凑模式的玩家下发紧凑帧数据，需要在帧锁内调用
+func (r *Room) sendCompactFrame(event *FrameEvent) {
+	if event == nil {
+		return
+	}
+	p := NewPreparedMessage(&ClientMessage{
+		Op:   FData,
+		Data: event,
+	})
+	for _, v := range r.users.List {
+		c := v.(*Client)
+		if c.compact && !c.catchingUp {
+			c.SendPrepared(p)
+		}
+	}
+}
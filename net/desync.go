@@ -0,0 +1,130 @@
+package net
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"websocket_server/logs"
+)
+
+// 每个房间最多保留的帧校验记录数量，超出时先比对最早的记录再移除
+const maxChecksumTicks = 300
+
+// 房间的帧校验记录，用于检测客户端之间的状态不同步
+type checksumState struct {
+	mu    sync.Mutex
+	ticks map[int]*tickChecksum // 帧序号 -> 校验记录
+	order []int                 // 按加入顺序排列的帧序号
+}
+
+// 单帧的校验记录
+type tickChecksum struct {
+	sums   map[int]string // uid -> 校验值
+	agreed string         // 比对后多数玩家一致的校验值
+	done   bool           // 是否已比对
+}
+
+func newChecksumState() *checksumState {
+	return &checksumState{
+		ticks: map[int]*tickChecksum{},
+	}
+}
+
+// 记录玩家上报的校验值，所有玩家都上报后进行比对，返回不同步事件（nil表示没有发现不同步）
+func (s *checksumState) report(tick int, uid int, sum string, players []int) []*DesyncEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []*DesyncEvent
+	t := s.ticks[tick]
+	if t == nil {
+		t = &tickChecksum{sums: map[int]string{}}
+		s.ticks[tick] = t
+		s.order = append(s.order, tick)
+		// 超出记录数量时，比对并移除最早的记录（可能有玩家一直没有上报）
+		for len(s.order) > maxChecksumTicks {
+			old := s.order[0]
+			s.order = s.order[1:]
+			if e := s.ticks[old].compare(old); e != nil {
+				events = append(events, e)
+			}
+			delete(s.ticks, old)
+		}
+	}
+	t.sums[uid] = sum
+	if t.done {
+		// 已比对过的帧，晚到的上报直接与多数玩家的校验值比对
+		if sum != t.agreed {
+			events = append(events, t.event(tick, []int{uid}))
+		}
+		return events
+	}
+	for _, p := range players {
+		if _, ok := t.sums[p]; !ok {
+			return events
+		}
+	}
+	if e := t.compare(tick); e != nil {
+		events = append(events, e)
+	}
+	return events
+}
+
+// 比对校验值，与多数玩家不一致的玩家视为不同步；数量相同时所有玩家都视为不同步
+func (t *tickChecksum) compare(tick int) *DesyncEvent {
+	if t.done {
+		return nil
+	}
+	t.done = true
+	counts := map[string]int{}
+	for _, sum := range t.sums {
+		counts[sum]++
+	}
+	best, tie := 0, false
+	for sum, n := range counts {
+		if n > best {
+			best, tie, t.agreed = n, false, sum
+		} else if n == best {
+			tie = true
+		}
+	}
+	if len(counts) <= 1 {
+		return nil
+	}
+	if tie {
+		t.agreed = ""
+	}
+	var uids []int
+	for uid, sum := range t.sums {
+		if sum != t.agreed {
+			uids = append(uids, uid)
+		}
+	}
+	return t.event(tick, uids)
+}
+
+func (t *tickChecksum) event(tick int, uids []int) *DesyncEvent {
+	sort.Ints(uids)
+	sums := make(map[int]string, len(t.sums))
+	for uid, sum := range t.sums {
+		sums[uid] = sum
+	}
+	return &DesyncEvent{T: tick, Uids: uids, Checksums: sums}
+}
+
+// 上报帧校验值，发现不同步时通知房间所有用户，并记录到房间历史消息与不同步记录中
+func (r *Room) reportChecksum(c *Client, tick int, sum string) error {
+	if tick < 1 || tick > r.cacheId {
+		return fmt.Errorf("帧序号无效，有效范围为1~%d", r.cacheId)
+	}
+	for _, e := range r.checksums.report(tick, c.uid, sum, r.activePlayers()) {
+		logs.InfoM("检测到状态不同步，房间ID:", r.id, "帧:", e.T, "用户:", e.Uids)
+		message := &ClientMessage{
+			Op:   Desync,
+			Data: e,
+		}
+		r.desyncs.Push(e)
+		r.recordRoomMessage(message)
+		r.SendToAllUserOp(message, nil)
+	}
+	return nil
+}
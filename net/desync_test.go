@@ -0,0 +1,89 @@
+package net
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChecksumCompare(t *testing.T) {
+	tests := []struct {
+		name   string
+		sums   map[int]string
+		agreed string
+		uids   []int // nil表示没有发现不同步
+	}{
+		{"全部一致", map[int]string{1: "a", 2: "a", 3: "a"}, "a", nil},
+		{"单个玩家", map[int]string{1: "a"}, "a", nil},
+		{"少数不一致", map[int]string{1: "a", 2: "a", 3: "b"}, "a", []int{3}},
+		{"多个少数派", map[int]string{1: "a", 2: "a", 3: "a", 4: "b", 5: "c"}, "a", []int{4, 5}},
+		{"两人不一致", map[int]string{1: "a", 2: "b"}, "", []int{1, 2}},
+		{"数量相同", map[int]string{1: "a", 2: "a", 3: "b", 4: "b"}, "", []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &tickChecksum{sums: tt.sums}
+			e := c.compare(5)
+			if c.agreed != tt.agreed {
+				t.Errorf("agreed = %q, want %q", c.agreed, tt.agreed)
+			}
+			if tt.uids == nil {
+				if e != nil {
+					t.Fatalf("event = %+v, want none", e)
+				}
+				return
+			}
+			if e == nil || e.T != 5 || !reflect.DeepEqual(e.Uids, tt.uids) {
+				t.Fatalf("event = %+v, want uids %v", e, tt.uids)
+			}
+			if !reflect.DeepEqual(e.Checksums, tt.sums) {
+				t.Errorf("checksums = %v, want %v", e.Checksums, tt.sums)
+			}
+			if c.compare(5) != nil {
+				t.Error("compare twice should not report again")
+			}
+		})
+	}
+}
+
+func TestChecksumReport(t *testing.T) {
+	s := newChecksumState()
+	players := []int{1, 2, 3}
+	// 所有玩家都上报后才比对
+	if e := s.report(1, 1, "a", players); e != nil {
+		t.Fatalf("report before all players = %v", e)
+	}
+	if e := s.report(1, 2, "a", players); e != nil {
+		t.Fatalf("report before all players = %v", e)
+	}
+	e := s.report(1, 3, "b", players)
+	if len(e) != 1 || !reflect.DeepEqual(e[0].Uids, []int{3}) {
+		t.Fatalf("report = %v, want uid 3 desynced", e)
+	}
+	// 比对后晚到的上报与多数玩家的校验值比对
+	if e := s.report(1, 4, "a", players); e != nil {
+		t.Errorf("late matching report = %v", e)
+	}
+	if e := s.report(1, 5, "c", players); len(e) != 1 || !reflect.DeepEqual(e[0].Uids, []int{5}) {
+		t.Errorf("late mismatching report = %v, want uid 5", e)
+	}
+}
+
+// 超出记录数量时比对最早的记录，没有上报的玩家不影响比对
+func TestChecksumEvict(t *testing.T) {
+	s := newChecksumState()
+	players := []int{1, 2, 3}
+	s.report(1, 1, "a", players)
+	s.report(1, 2, "b", players)
+	for tick := 2; tick <= maxChecksumTicks; tick++ {
+		if e := s.report(tick, 1, "a", players); e != nil {
+			t.Fatalf("tick %d: %v", tick, e)
+		}
+	}
+	e := s.report(maxChecksumTicks+1, 1, "a", players)
+	if len(e) != 1 || e[0].T != 1 || !reflect.DeepEqual(e[0].Uids, []int{1, 2}) {
+		t.Fatalf("evict = %v, want tick 1 with uids [1 2]", e)
+	}
+	if _, ok := s.ticks[1]; ok || len(s.order) != maxChecksumTicks {
+		t.Errorf("tick 1 kept, %d ticks recorded", len(s.order))
+	}
+}
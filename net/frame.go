@@ -1,6 +1,7 @@
 package net
 
 type FrameData struct {
-	Time int64 // 时间戳
+	Time int64 // 服务器收到操作的时间（Unix毫秒）
+	Tick int   // 生效的帧序号
 	Data any   // 帧数据
 }
@@ -0,0 +1,71 @@
+package net
+
+import (
+	"fmt"
+	"time"
+)
+
+// 帧率变更最多可提前公布的帧数
+const maxRateChangeLead = 3600
+
+// 帧率变更
+type FrameRateEvent struct {
+	FPS  float64 `json:"fps"`  // 新的帧率
+	At   int     `json:"at"`   // 生效的帧序号：第at-1帧与第at帧的间隔起按新的帧率
+	Prev float64 `json:"prev"` // 变更前的帧率
+}
+
+// 当前帧率
+func (r *Room) fps() float64 {
+	return float64(time.Second) / float64(r.interval)
+}
+
+// 当前帧间隔，帧率可能在帧同步中变更，需要在帧锁外调用
+func (r *Room) frameInterval() time.Duration {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	return r.interval
+}
+
+// 修改帧同步中的帧率，at为生效的帧序号（0表示尽快生效，即下一帧之后的一帧）。
+// 变更会立即通知房间所有成员，尚未生效时再次修改会替换之前的变更
+func (r *Room) ChangeFrameRate(fps float64, at int) (*FrameRateEvent, error) {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	if !r.frameSync {
+		return nil, fmt.Errorf("房间未开启帧同步")
+	}
+	// 第at-1帧下发后切换帧率，所以最早在下一帧之后的一帧生效
+	earliest := r.cacheId + 2
+	if at == 0 {
+		at = earliest
+	} else if at < earliest {
+		return nil, fmt.Errorf("帧率变更最早在第%d帧生效", earliest)
+	} else if at > r.cacheId+maxRateChangeLead {
+		return nil, fmt.Errorf("帧率变更最多提前%d帧公布", maxRateChangeLead)
+	}
+	event := &FrameRateEvent{FPS: fps, At: at, Prev: r.fps()}
+	if n := len(r.rateChanges); n > 0 && r.rateChanges[n-1].At > r.cacheId {
+		// 替换尚未生效的变更
+		r.rateChanges[n-1] = event
+	} else {
+		r.rateChanges = append(r.rateChanges, event)
+	}
+	// 在帧锁内通知，保证通知先于第at帧的FData送达
+	r.SendToAllUserOp(&ClientMessage{
+		Op:   FrameRateChanged,
+		Data: event,
+	}, nil)
+	return event, nil
+}
+
+// 下发第tick帧后，切换在下一帧生效的帧率。需要在帧锁内调用
+func (r *Room) applyRateChange(tick int) {
+	n := len(r.rateChanges)
+	if n == 0 || r.rateChanges[n-1].At != tick+1 {
+		return
+	}
+	fps := r.rateChanges[n-1].FPS
+	r.interval = time.Duration(float64(time.Second) / fps)
+	r.option.fps = fps
+}
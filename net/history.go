@@ -0,0 +1,255 @@
+package net
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"websocket_server/logs"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 帧历史的保留策略，内存中的帧超出保留帧数后如何处理更早的帧
+type FrameRetention string
+
+const (
+	RetainSpill   FrameRetention = "spill"   // 写入磁盘，仍可通过GetFrameAt、追帧、回放获取
+	RetainDiscard FrameRetention = "discard" // 直接丢弃
+)
+
+// 写入磁盘时每个分段包含的帧数
+const frameSegmentTicks = 256
+
+// 房间本局帧同步的帧历史：内存中保留最近的帧（环形缓冲区），更早的帧按保留策略写入磁盘或丢弃。
+// 由房间的frameMu保护
+type frameHistory struct {
+	ring    []historyFrame // 内存中的帧
+	head    int            // ring中最早一帧的位置
+	first   int            // 内存中最早一帧的帧序号
+	last    int            // 最新一帧的帧序号
+	limit   int            // 内存中保留的帧数，0表示不限制
+	policy  FrameRetention
+	evicted int            // 已丢弃的最后一帧
+	dir     string         // 溢出文件的目录
+	file    *os.File       // 溢出文件，按分段追加写入gzip压缩的帧JSON
+	spilled []frameSegment // 已写入磁盘的分段
+	pending []historyFrame // 等待写入磁盘的帧（不足一个分段）
+	cached  int            // 最近读取的分段序号（-1表示没有）
+	segment []historyFrame // 最近读取的分段
+}
+
+// 帧历史中的一帧，帧时间与帧数据一起保留、写入磁盘或丢弃
+type historyFrame struct {
+	Time int64         `json:"time"` // 相对开始帧同步时间的毫秒数
+	D    map[int][]any `json:"d"`    // uid -> 该帧的操作列表
+}
+
+// 磁盘中的分段
+type frameSegment struct {
+	offset int64
+	size   int
+}
+
+func newFrameHistory(option *AppOption) *frameHistory {
+	return &frameHistory{
+		first:  1,
+		limit:  option.FrameHistory,
+		policy: option.FrameRetention,
+		dir:    CurrentServer.FrameSpillDir,
+		cached: -1,
+	}
+}
+
+// 追加一帧（ms为相对开始帧同步时间的毫秒数），超出保留帧数时淘汰最早的帧
+func (h *frameHistory) push(d map[int][]any, ms int64) {
+	frame := historyFrame{Time: ms, D: d}
+	h.last++
+	if h.limit <= 0 || len(h.ring) < h.limit {
+		h.ring = append(h.ring, frame)
+		return
+	}
+	oldest := h.ring[h.head]
+	h.ring[h.head] = frame
+	h.head = (h.head + 1) % len(h.ring)
+	h.first++
+	h.evict(oldest)
+}
+
+// 淘汰内存中最早的一帧（帧序号为first-1）
+func (h *frameHistory) evict(frame historyFrame) {
+	if h.policy == RetainDiscard {
+		h.evicted = h.first - 1
+		return
+	}
+	h.pending = append(h.pending, frame)
+	if len(h.pending) < frameSegmentTicks {
+		return
+	}
+	if err := h.writeSegment(); err != nil {
+		// 写入失败时改为丢弃，已写入磁盘的帧也视为已清除
+		logs.InfoM("帧历史写入磁盘失败，改为丢弃超出的帧：", err)
+		h.close()
+		h.policy = RetainDiscard
+		h.spilled = nil
+		h.evicted = h.first - 1
+	}
+	h.pending = nil
+}
+
+// 把等待写入的帧作为一个分段写入溢出文件
+func (h *frameHistory) writeSegment() error {
+	if h.file == nil {
+		f, err := os.CreateTemp(h.dir, "hxonline-frames-*.tmp")
+		if err != nil {
+			return err
+		}
+		h.file = f
+	}
+	z, err := gzipJSON(h.pending)
+	if err != nil {
+		return err
+	}
+	offset, err := h.file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if _, err = h.file.Write(z); err != nil {
+		return err
+	}
+	h.spilled = append(h.spilled, frameSegment{offset: offset, size: len(z)})
+	return nil
+}
+
+// 可以获取到的最早一帧
+func (h *frameHistory) oldest() int {
+	return h.evicted + 1
+}
+
+// 获取指定帧
+func (h *frameHistory) get(tick int) (map[int][]any, error) {
+	frame, err := h.frame(tick)
+	return frame.D, err
+}
+
+// 获取指定帧的时间（相对开始帧同步时间的毫秒数）
+func (h *frameHistory) time(tick int) (int64, error) {
+	frame, err := h.frame(tick)
+	return frame.Time, err
+}
+
+// 获取指定帧的数据和时间
+func (h *frameHistory) frame(tick int) (historyFrame, error) {
+	if tick < 1 || tick > h.last {
+		return historyFrame{}, fmt.Errorf("帧范围无效，有效范围为1~%d", h.last)
+	}
+	if tick <= h.evicted {
+		return historyFrame{}, fmt.Errorf("第%d帧已被清除，最早可获取第%d帧", tick, h.oldest())
+	}
+	if tick >= h.first {
+		return h.ring[(h.head+tick-h.first)%len(h.ring)], nil
+	}
+	index := (tick - 1) / frameSegmentTicks
+	if index >= len(h.spilled) {
+		return h.pending[tick-1-len(h.spilled)*frameSegmentTicks], nil
+	}
+	if index != h.cached {
+		segment, err := h.readSegment(h.spilled[index])
+		if err != nil {
+			return historyFrame{}, fmt.Errorf("读取第%d帧失败：%s", tick, err.Error())
+		}
+		h.cached, h.segment = index, segment
+	}
+	return h.segment[(tick-1)%frameSegmentTicks], nil
+}
+
+// 从溢出文件读取一个分段
+func (h *frameHistory) readSegment(s frameSegment) ([]historyFrame, error) {
+	if h.file == nil {
+		return nil, fmt.Errorf("溢出文件已关闭")
+	}
+	z := make([]byte, s.size)
+	if _, err := h.file.ReadAt(z, s.offset); err != nil {
+		return nil, err
+	}
+	r, err := gzip.NewReader(bytes.NewReader(z))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var frames []historyFrame
+	if err := jsoniter.ConfigCompatibleWithStandardLibrary.NewDecoder(r).Decode(&frames); err != nil {
+		return nil, err
+	}
+	return frames, nil
+}
+
+// 关闭并删除溢出文件
+func (h *frameHistory) close() {
+	if h.file != nil {
+		h.file.Close()
+		os.Remove(h.file.Name())
+		h.file = nil
+	}
+	h.cached, h.segment = -1, nil
+}
+
+// 复制帧历史用于在帧锁外读取（停止帧同步后不会再追加帧），溢出文件会重新打开，
+// 读取完成后需要调用release
+func (h *frameHistory) snapshot() *frameHistory {
+	s := *h
+	s.cached, s.segment = -1, nil
+	if h.file != nil {
+		// 重新打开失败时溢出的帧读取失败，不影响内存中的帧
+		s.file, _ = os.Open(h.file.Name())
+	}
+	return &s
+}
+
+// 关闭快照打开的溢出文件（不删除）
+func (h *frameHistory) release() {
+	if h.file != nil {
+		h.file.Close()
+		h.file = nil
+	}
+	h.cached, h.segment = -1, nil
+}
+
+// 重新开始记录帧历史（开始帧同步、重置房间时调用），会删除之前的溢出文件
+func (r *Room) resetFrameHistory() {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	r.history.close()
+	r.history = newFrameHistory(r.master.getApp().option)
+}
+
+// 分页获取帧数据：[start, end)对应第start+1~end帧，end为0时获取一页。
+// 观战用户只能获取已延迟下发的帧，超出范围或已被清除的帧返回错误
+func (r *Room) getFrames(c *Client, start int, end int) ([]map[int][]any, error) {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	// 停止帧同步后帧序号会重新计数，但本局的帧历史会保留到下次开始帧同步
+	length := r.history.last - (r.cacheId - r.visibleTick(c))
+	if end == 0 {
+		end = start + maxFramePage
+		if end > length {
+			end = length
+		}
+	}
+	if start > end || end > length {
+		return nil, fmt.Errorf("帧范围无效，有效范围为0~%d", length)
+	}
+	if end-start > maxFramePage {
+		return nil, fmt.Errorf("每次最多获取%d帧", maxFramePage)
+	}
+	frames := make([]map[int][]any, 0, end-start)
+	for t := start + 1; t <= end; t++ {
+		d, err := r.history.get(t)
+		if err != nil {
+			return nil, err
+		}
+		frames = append(frames, d)
+	}
+	return frames, nil
+}
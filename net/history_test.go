@@ -0,0 +1,170 @@
+package net
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// 第t帧的测试数据
+func testFrame(t int) map[int][]any {
+	return map[int][]any{1: {fmt.Sprint(t)}}
+}
+
+// 写入磁盘后再读取的帧经过JSON编码，按字符串比对
+func frameString(frame map[int][]any) string {
+	return fmt.Sprint(frame[1])
+}
+
+func TestFrameHistory(t *testing.T) {
+	const ticks = frameSegmentTicks*2 + 30
+	tests := []struct {
+		name    string
+		limit   int
+		policy  FrameRetention
+		oldest  int
+		spilled int // 写入磁盘的分段数
+	}{
+		{"不限制", 0, RetainSpill, 1, 0},
+		{"写入磁盘", 20, RetainSpill, 1, 2},
+		{"丢弃", 20, RetainDiscard, ticks - 19, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &frameHistory{first: 1, limit: tt.limit, policy: tt.policy, dir: t.TempDir(), cached: -1}
+			defer h.close()
+			for i := 1; i <= ticks; i++ {
+				h.push(testFrame(i), int64(i))
+			}
+			if h.oldest() != tt.oldest {
+				t.Fatalf("oldest = %d, want %d", h.oldest(), tt.oldest)
+			}
+			if len(h.spilled) != tt.spilled {
+				t.Errorf("spilled %d segments, want %d", len(h.spilled), tt.spilled)
+			}
+			if tt.limit > 0 && len(h.ring) != tt.limit {
+				t.Errorf("ring holds %d frames, want %d", len(h.ring), tt.limit)
+			}
+			// 按内存、磁盘分段、等待写入的帧交替读取，覆盖分段缓存的切换
+			for _, tick := range []int{ticks, 1, frameSegmentTicks + 1, 2, ticks - 20, frameSegmentTicks*2 + 1, ticks - 19} {
+				d, err := h.get(tick)
+				if tick < tt.oldest {
+					if err == nil {
+						t.Errorf("get(%d) should fail after discard", tick)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("get(%d): %v", tick, err)
+				}
+				if got, want := frameString(d), fmt.Sprint([]any{fmt.Sprint(tick)}); got != want {
+					t.Errorf("get(%d) = %s, want %s", tick, got, want)
+				}
+				// 帧时间与帧数据一起保留或写入磁盘
+				if ms, err := h.time(tick); err != nil || ms != int64(tick) {
+					t.Errorf("time(%d) = %d (%v), want %d", tick, ms, err, tick)
+				}
+			}
+			if _, err := h.get(ticks + 1); err == nil {
+				t.Error("get beyond last should fail")
+			}
+		})
+	}
+}
+
+// 快照重新打开溢出文件，原帧历史关闭并删除溢出文件后仍可读取
+func TestFrameHistorySnapshot(t *testing.T) {
+	h := &frameHistory{first: 1, limit: 20, policy: RetainSpill, dir: t.TempDir(), cached: -1}
+	for i := 1; i <= frameSegmentTicks+30; i++ {
+		h.push(testFrame(i), int64(i))
+	}
+	s := h.snapshot()
+	defer s.release()
+	h.close()
+	for _, tick := range []int{1, frameSegmentTicks + 1, frameSegmentTicks + 30} {
+		d, err := s.get(tick)
+		if err != nil {
+			t.Fatalf("get(%d): %v", tick, err)
+		}
+		if got, want := frameString(d), fmt.Sprint([]any{fmt.Sprint(tick)}); got != want {
+			t.Errorf("get(%d) = %s, want %s", tick, got, want)
+		}
+	}
+}
+
+func TestGetFrames(t *testing.T) {
+	r := &Room{history: &frameHistory{first: 1, limit: 10, policy: RetainDiscard, cached: -1}}
+	for i := 1; i <= 30; i++ {
+		r.history.push(testFrame(i), int64(i))
+		r.cacheId++
+	}
+	r.spectatorTick = 25
+	player := &Client{}
+	spectator := &Client{spectator: true}
+	tests := []struct {
+		name       string
+		c          *Client
+		start, end int
+		from, to   int // 期望获取第from~to帧，from为0表示失败
+	}{
+		{"一页", player, 20, 0, 21, 30},
+		{"指定范围", player, 22, 25, 23, 25},
+		{"已被清除", player, 5, 10, 0, 0},
+		{"超出范围", player, 25, 31, 0, 0},
+		{"开始大于结束", player, 25, 24, 0, 0},
+		{"观战延迟", spectator, 20, 0, 21, 25},
+		{"观战超出延迟", spectator, 20, 26, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frames, err := r.getFrames(tt.c, tt.start, tt.end)
+			if tt.from == 0 {
+				if err == nil {
+					t.Fatalf("got %d frames, want error", len(frames))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want []map[int][]any
+			for i := tt.from; i <= tt.to; i++ {
+				want = append(want, testFrame(i))
+			}
+			if !reflect.DeepEqual(frames, want) {
+				t.Errorf("frames = %v, want %v", frames, want)
+			}
+		})
+	}
+}
+
+// 观战延迟按帧历史中的帧时间计算，已被清除的帧视为已超过延迟
+func TestDelayedTick(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy FrameRetention
+		want   int
+	}{
+		{"写入磁盘", RetainSpill, 5},
+		{"丢弃", RetainDiscard, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Room{
+				history:   &frameHistory{first: 1, limit: 4, policy: tt.policy, dir: t.TempDir(), cached: -1},
+				syncStart: time.Now().Add(-time.Second),
+				option:    &RoomConfigOption{spectatorDelay: 500},
+			}
+			defer r.history.close()
+			// 第1~5帧超过延迟，第6~10帧未超过
+			for i := 1; i <= 10; i++ {
+				r.cacheId++
+				r.history.push(testFrame(i), int64(i*100))
+			}
+			if got := r.delayedTick(false); got != tt.want {
+				t.Errorf("delayedTick = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package net
+
+import (
+	"fmt"
+	"time"
+	"websocket_server/logs"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 玩家操作的限制参数
+const (
+	defaultFrameInputs = 8     // 默认每个玩家每帧最多提交的操作数
+	maxFrameInputs     = 64    // 每帧最多提交的操作数上限
+	defaultInputSize   = 1024  // 默认单个操作的最大字节数
+	maxInputSize       = 65536 // 单个操作的最大字节数上限
+)
+
+// 帧操作校验器，扩展实现ValidateFrame方法并通过Server.Register注册后，
+// 创建房间时可通过validator（扩展的类型名称）为房间启用。会在各用户的协程中并发调用
+type FrameValidator interface {
+	// 校验玩家上传的操作，返回的值替换原操作（可用于清理数据），返回错误时拒绝该操作
+	ValidateFrame(input *FrameInput) (any, error)
+}
+
+// 待校验的玩家操作
+type FrameInput struct {
+	AppId  string // 应用ID
+	RoomId int    // 房间ID
+	Uid    int    // 提交操作的用户ID
+	Tick   int    // 房间当前帧
+	Data   any    // 操作数据
+}
+
+// 注册帧操作校验器
+func (s *Server) registerFrameValidator(name string, api any) {
+	v, ok := api.(FrameValidator)
+	if !ok {
+		logs.ErrorF("帧操作校验器%s的ValidateFrame方法签名错误，需要func(*net.FrameInput) (any, error)", name)
+		return
+	}
+	s.frameValidators[name] = v
+}
+
+// 补全房间的操作限制参数
+func (o *RoomConfigOption) normalizeInput() {
+	if o.maxInputs <= 0 {
+		o.maxInputs = defaultFrameInputs
+	} else if o.maxInputs > maxFrameInputs {
+		o.maxInputs = maxFrameInputs
+	}
+	if o.maxInputSize <= 0 {
+		o.maxInputSize = defaultInputSize
+	} else if o.maxInputSize > maxInputSize {
+		o.maxInputSize = maxInputSize
+	}
+}
+
+// 检查玩家上传的操作：大小限制（按JSON编码后的字节数计算）与房间的校验器，返回处理后的操作
+func (r *Room) checkInput(c *Client, data any) (any, error) {
+	size, err := inputSize(data)
+	if err != nil {
+		return nil, fmt.Errorf("操作数据无效：%s", err.Error())
+	}
+	if size > r.option.maxInputSize {
+		return nil, fmt.Errorf("操作数据过大（%d字节），最大%d字节", size, r.option.maxInputSize)
+	}
+	if r.option.validator == "" {
+		return data, nil
+	}
+	v := CurrentServer.frameValidators[r.option.validator]
+	if v == nil {
+		return nil, fmt.Errorf("帧操作校验器%s不存在", r.option.validator)
+	}
+	return v.ValidateFrame(&FrameInput{
+		AppId:  c.appid,
+		RoomId: r.id,
+		Uid:    c.uid,
+		Tick:   r.cacheId,
+		Data:   data,
+	})
+}
+
+// 操作数据编码为JSON后的字节数
+func inputSize(data any) (int, error) {
+	if data == nil {
+		return 0, nil
+	}
+	b, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(data)
+	return len(b), err
+}
+
+// 迟到操作的处理策略：操作到达时，期望生效的帧已经下发
+type LatePolicy string
+
+const (
+	LateShift LatePolicy = "shift" // 顺延到下一帧生效（默认）
+	LateDrop  LatePolicy = "drop"  // 丢弃并回复错误
+)
+
+// 玩家本局帧同步的操作到达统计
+type InputStats struct {
+	Inputs    int     `json:"inputs"`    // 提交的操作数
+	Targeted  int     `json:"targeted"`  // 指定了期望帧的操作数
+	AvgLead   float64 `json:"avgLead"`   // 指定了期望帧的操作，期望帧相对尚未下发的第一帧的平均提前帧数（迟到为负数）
+	Late      int     `json:"late"`      // 晚于期望帧到达的操作数
+	Dropped   int     `json:"dropped"`   // 因迟到被丢弃的操作数
+	MaxLate   int     `json:"maxLate"`   // 最大迟到帧数
+	AvgLate   float64 `json:"avgLate"`   // 迟到操作的平均迟到帧数
+	Delivered int     `json:"delivered"` // 已随帧下发的操作数
+	AvgWait   float64 `json:"avgWait"`   // 操作从服务器收到到随帧下发的平均等待时间（毫秒）
+	MaxWait   int64   `json:"maxWait"`   // 最大等待时间（毫秒）
+	leadSum   int
+	lateSum   int
+	waitSum   int64
+}
+
+// 玩家的操作到达统计，需要在帧锁内调用
+func (r *Room) playerInputStats(uid int) *InputStats {
+	stats := r.inputStats[uid]
+	if stats == nil {
+		stats = &InputStats{}
+		r.inputStats[uid] = stats
+	}
+	return stats
+}
+
+// 记录一次提交，tick为客户端期望生效的帧（0表示未指定），next为尚未下发的第一帧，
+// 返回是否丢弃该操作。需要在帧锁内调用
+func (r *Room) recordInput(uid int, tick int, next int) bool {
+	stats := r.playerInputStats(uid)
+	stats.Inputs++
+	if tick <= 0 {
+		return false
+	}
+	stats.Targeted++
+	stats.leadSum += tick - next
+	stats.AvgLead = float64(stats.leadSum) / float64(stats.Targeted)
+	late := next - tick
+	if late <= 0 {
+		return false
+	}
+	stats.Late++
+	stats.lateSum += late
+	stats.AvgLate = float64(stats.lateSum) / float64(stats.Late)
+	if late > stats.MaxLate {
+		stats.MaxLate = late
+	}
+	if r.option.frameSync.Late == LateDrop {
+		stats.Dropped++
+		return true
+	}
+	return false
+}
+
+// 自由下发模式：按期望生效的帧（tick为0时为下一帧）缓存玩家的操作，返回该操作生效的帧序号。
+// 期望的帧已收集过时按迟到策略顺延或丢弃
+func (r *Room) queueInput(c *Client, data any, tick int) (int, error) {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	next := r.collected + 1
+	if tick > r.collected+maxInputAhead {
+		return 0, fmt.Errorf("提交的操作超前过多，最多可提前%d帧", maxInputAhead)
+	}
+	// 未指定或迟到时在下一帧生效
+	target := tick
+	if target < next {
+		target = next
+	}
+	count := 0
+	for _, v := range c.frames.List {
+		if f, ok := v.(FrameData); ok && f.Tick == target {
+			count++
+		}
+	}
+	if count >= r.option.maxInputs {
+		return 0, fmt.Errorf("每帧最多提交%d个操作", r.option.maxInputs)
+	}
+	if r.recordInput(c.uid, tick, next) {
+		return 0, fmt.Errorf("第%d帧已下发，迟到的操作已丢弃", tick)
+	}
+	c.frames.Push(FrameData{
+		Time: time.Now().UnixMilli(),
+		Tick: target,
+		Data: data,
+	})
+	return target, nil
+}
+
+// 锁步模式：检查操作是否晚于期望的帧（tick为0时不检查），迟到且策略为丢弃时返回错误。
+// 未丢弃的操作仍按锁步的规则分配生效的帧
+func (r *Room) checkLockstepInput(c *Client, l *lockstepState, tick int) error {
+	next := l.sent() + 1
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	if r.recordInput(c.uid, tick, next) {
+		return fmt.Errorf("第%d帧已下发，迟到的操作已丢弃", tick)
+	}
+	return nil
+}
+
+// 取出各玩家的操作数据，并记录操作从服务器收到到随帧下发的等待时间。需要在帧锁内调用
+func (r *Room) frameInputs(inputs map[int][]FrameData) map[int][]any {
+	now := time.Now().UnixMilli()
+	frame := make(map[int][]any, len(inputs))
+	for uid, list := range inputs {
+		data := make([]any, 0, len(list))
+		for _, f := range list {
+			data = append(data, f.Data)
+			r.recordWait(uid, now-f.Time)
+		}
+		frame[uid] = data
+	}
+	return frame
+}
+
+// 记录一个操作的等待时间（毫秒），需要在帧锁内调用
+func (r *Room) recordWait(uid int, wait int64) {
+	stats := r.playerInputStats(uid)
+	stats.Delivered++
+	stats.waitSum += wait
+	stats.AvgWait = float64(stats.waitSum) / float64(stats.Delivered)
+	if wait > stats.MaxWait {
+		stats.MaxWait = wait
+	}
+}
+
+// 本局帧同步各玩家的操作到达统计（uid -> 统计）
+func (r *Room) copyInputStats() map[int]InputStats {
+	list := make(map[int]InputStats, len(r.inputStats))
+	for uid, stats := range r.inputStats {
+		list[uid] = *stats
+	}
+	return list
+}
@@ -0,0 +1,127 @@
+package net
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"websocket_server/util"
+)
+
+func newInputTestRoom(late LatePolicy) (*Room, *Client) {
+	r := &Room{
+		collected:  10,
+		inputStats: map[int]*InputStats{},
+		option:     &RoomConfigOption{maxInputs: 2, frameSync: FrameSyncOption{Late: late}},
+	}
+	return r, &Client{uid: 1, frames: util.CreateArray()}
+}
+
+func TestQueueInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		late   LatePolicy
+		tick   int
+		target int // 0表示提交失败
+		stats  InputStats
+	}{
+		{"下一帧", LateShift, 0, 11, InputStats{Inputs: 1}},
+		{"指定的帧", LateShift, 15, 15, InputStats{Inputs: 1, Targeted: 1, AvgLead: 4}},
+		{"迟到顺延", LateShift, 8, 11, InputStats{Inputs: 1, Targeted: 1, AvgLead: -3, Late: 1, MaxLate: 3, AvgLate: 3}},
+		{"迟到丢弃", LateDrop, 10, 0, InputStats{Inputs: 1, Targeted: 1, AvgLead: -1, Late: 1, Dropped: 1, MaxLate: 1, AvgLate: 1}},
+		{"超前过多", LateShift, 11 + maxInputAhead, 0, InputStats{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, c := newInputTestRoom(tt.late)
+			target, err := r.queueInput(c, "a", tt.tick)
+			if tt.target == 0 {
+				if err == nil {
+					t.Fatalf("target = %d, want error", target)
+				}
+				if c.frames.Length() != 0 {
+					t.Errorf("rejected input queued")
+				}
+			} else if err != nil || target != tt.target {
+				t.Fatalf("target = %d (%v), want %d", target, err, tt.target)
+			} else if f := c.frames.List[0].(FrameData); f.Tick != tt.target {
+				t.Errorf("queued tick = %d, want %d", f.Tick, tt.target)
+			}
+			var stats InputStats
+			if s := r.inputStats[c.uid]; s != nil {
+				stats = *s
+				stats.leadSum, stats.lateSum = 0, 0
+			}
+			if stats != tt.stats {
+				t.Errorf("stats = %+v, want %+v", stats, tt.stats)
+			}
+		})
+	}
+}
+
+// 每帧的操作数量限制按实际生效的帧计算，迟到顺延的操作计入下一帧
+func TestQueueInputLimit(t *testing.T) {
+	r, c := newInputTestRoom(LateShift)
+	for _, tick := range []int{0, 5} {
+		if _, err := r.queueInput(c, "a", tick); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := r.queueInput(c, "a", 11); err == nil {
+		t.Fatal("third input for tick 11 should fail")
+	}
+	if target, err := r.queueInput(c, "a", 12); err != nil || target != 12 {
+		t.Fatalf("target = %d (%v), want 12", target, err)
+	}
+	stats := r.inputStats[c.uid]
+	if stats.Inputs != 3 || stats.Late != 1 || stats.MaxLate != 6 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestCheckLockstepInput(t *testing.T) {
+	tests := []struct {
+		name string
+		late LatePolicy
+		tick int
+		ok   bool
+		want int // 迟到的帧数
+	}{
+		{"不指定帧", LateDrop, 0, true, 0},
+		{"未下发的帧", LateDrop, 6, true, 0},
+		{"迟到顺延", LateShift, 4, true, 2},
+		{"迟到丢弃", LateDrop, 5, false, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, c := newInputTestRoom(tt.late)
+			l := newLockstepState(FrameSyncOption{Mode: Lockstep}, 5)
+			err := r.checkLockstepInput(c, l, tt.tick)
+			if (err == nil) != tt.ok {
+				t.Fatalf("err = %v, want ok=%v", err, tt.ok)
+			}
+			if got := r.inputStats[c.uid].MaxLate; got != tt.want {
+				t.Errorf("late = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// 随帧下发时记录操作从服务器收到到下发的等待时间，填充的空操作不计入
+func TestFrameInputs(t *testing.T) {
+	r, _ := newInputTestRoom(LateShift)
+	now := time.Now().UnixMilli()
+	frame := r.frameInputs(map[int][]FrameData{
+		1: {{Time: now - 30, Data: "a"}, {Time: now - 10, Data: "b"}},
+		2: {},
+	})
+	if !reflect.DeepEqual(frame, map[int][]any{1: {"a", "b"}, 2: {}}) {
+		t.Fatalf("frame = %v", frame)
+	}
+	stats := r.inputStats[1]
+	if stats == nil || stats.Delivered != 2 || stats.MaxWait < 30 || stats.AvgWait < 20 {
+		t.Errorf("stats = %+v", stats)
+	}
+	if r.inputStats[2] != nil {
+		t.Errorf("filled input recorded: %+v", r.inputStats[2])
+	}
+}
@@ -0,0 +1,244 @@
+package net
+
+import (
+	"fmt"
+	"sync"
+	"time"
+	"websocket_server/logs"
+)
+
+// 帧同步模式
+type FrameSyncMode string
+
+const (
+	FreeRun  FrameSyncMode = "free"     // 按固定间隔下发帧，收集到多少操作就下发多少（默认）
+	Lockstep FrameSyncMode = "lockstep" // 锁步：每帧等待所有玩家提交该帧的操作后再下发
+)
+
+// 锁步模式下，玩家超时未提交操作时的处理策略
+type StragglerPolicy string
+
+const (
+	StragglerFill  StragglerPolicy = "fill"  // 以空操作填充该玩家，继续下发（默认）
+	StragglerStall StragglerPolicy = "stall" // 一直等待，直到玩家提交操作或离线
+	StragglerDrop  StragglerPolicy = "drop"  // 将玩家踢出房间，继续下发
+)
+
+// 锁步模式的参数范围
+const (
+	defaultLockstepTimeout = 500   // 默认每帧等待玩家操作的超时时间（毫秒）
+	maxLockstepTimeout     = 10000 // 最长超时时间（毫秒）
+	maxInputDelay          = 30    // 最大输入延迟（帧）
+	maxInputAhead          = 120   // 玩家最多可提前提交的帧数（不含输入延迟）
+)
+
+// 帧同步参数，创建房间与开启帧同步时可指定，未指定的字段沿用房间当前的配置
+type FrameSyncOption struct {
+	Mode       FrameSyncMode   `json:"mode,omitempty" validate:"oneof=free lockstep"`        // 帧同步模式：free、lockstep
+	InputDelay int             `json:"inputDelay,omitempty" validate:"min=0,max=30"`         // 锁步模式的输入延迟（帧），提交的操作最早在当前帧之后的第inputDelay+1帧生效
+	Timeout    int             `json:"timeout,omitempty" validate:"min=0,max=10000"`         // 锁步模式每帧等待玩家操作的超时时间（毫秒），0使用默认值500
+	Straggler  StragglerPolicy `json:"straggler,omitempty" validate:"oneof=fill stall drop"` // 锁步模式超时未提交操作的处理策略：fill、stall、drop
+	Late       LatePolicy      `json:"late,omitempty" validate:"oneof=shift drop"`           // 操作晚于期望的帧到达时的处理策略：shift、drop
+	IdleFold   int             `json:"idleFold,omitempty" validate:"min=0,max=60"`           // 紧凑帧数据最多合并的连续空帧数，0使用默认值8
+}
+
+// 合并参数，仅覆盖已指定的字段
+func (o *FrameSyncOption) merge(v *FrameSyncOption) {
+	if v == nil {
+		return
+	}
+	if v.Mode != "" {
+		o.Mode = v.Mode
+	}
+	if v.InputDelay != 0 {
+		o.InputDelay = v.InputDelay
+	}
+	if v.Timeout != 0 {
+		o.Timeout = v.Timeout
+	}
+	if v.Straggler != "" {
+		o.Straggler = v.Straggler
+	}
+	if v.IdleFold != 0 {
+		o.IdleFold = v.IdleFold
+	}
+	if v.Late != "" {
+		o.Late = v.Late
+	}
+}
+
+// 补全默认值
+func (o FrameSyncOption) normalize() FrameSyncOption {
+	if o.Mode == "" {
+		o.Mode = FreeRun
+	}
+	if o.Late == "" {
+		o.Late = LateShift
+	}
+	if o.IdleFold <= 0 {
+		o.IdleFold = defaultIdleFold
+	} else if o.IdleFold > maxIdleFold {
+		o.IdleFold = maxIdleFold
+	}
+	if o.Mode == Lockstep {
+		if o.Timeout <= 0 {
+			o.Timeout = defaultLockstepTimeout
+		} else if o.Timeout > maxLockstepTimeout {
+			o.Timeout = maxLockstepTimeout
+		}
+		if o.InputDelay < 0 {
+			o.InputDelay = 0
+		} else if o.InputDelay > maxInputDelay {
+			o.InputDelay = maxInputDelay
+		}
+		if o.Straggler == "" {
+			o.Straggler = StragglerFill
+		}
+	} else {
+		o.InputDelay = 0
+		o.Timeout = 0
+		o.Straggler = ""
+	}
+	return o
+}
+
+// 锁步模式的状态
+type lockstepState struct {
+	mu        sync.Mutex
+	delay     int
+	timeout   time.Duration
+	straggler StragglerPolicy
+	tick      int                         // 已下发的帧序号
+	inputs    map[int]map[int][]FrameData // 帧序号 -> uid -> 操作列表
+	next      map[int]int                 // uid -> 下一次提交对应的帧序号
+	deadline  time.Time                   // 等待当前帧的超时时间（由帧调度器访问）
+	stalled   bool                        // 当前帧是否已超时暂停（由帧调度器访问）
+}
+
+func newLockstepState(option FrameSyncOption, tick int) *lockstepState {
+	return &lockstepState{
+		delay:     option.InputDelay,
+		timeout:   time.Duration(option.Timeout) * time.Millisecond,
+		straggler: option.Straggler,
+		tick:      tick,
+		inputs:    map[int]map[int][]FrameData{},
+		next:      map[int]int{},
+	}
+}
+
+// 提交玩家的操作，每次提交对应一帧，返回该操作生效的帧序号。
+// 操作最早在当前帧之后的第delay+1帧生效，连续提交时依次对应后续的帧
+func (l *lockstepState) push(uid int, data any) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tick := l.tick + 1 + l.delay
+	if next := l.next[uid]; next > tick {
+		tick = next
+	}
+	if tick > l.tick+l.delay+maxInputAhead {
+		return 0, fmt.Errorf("提交的操作超前过多，最多可提前%d帧", maxInputAhead)
+	}
+	frame := l.inputs[tick]
+	if frame == nil {
+		frame = map[int][]FrameData{}
+		l.inputs[tick] = frame
+	}
+	frame[uid] = append(frame[uid], FrameData{Time: time.Now().UnixMilli(), Tick: tick, Data: data})
+	l.next[uid] = tick + 1
+	return tick, nil
+}
+
+// 已下发的帧序号
+func (l *lockstepState) sent() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.tick
+}
+
+// 未提交指定帧操作的玩家（输入延迟内的帧无需等待）
+func (l *lockstepState) missing(tick int, uids []int) []int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if tick <= l.delay {
+		return nil
+	}
+	var out []int
+	for _, uid := range uids {
+		if _, ok := l.inputs[tick][uid]; !ok {
+			out = append(out, uid)
+		}
+	}
+	return out
+}
+
+// 取出指定帧的操作，fill中的玩家以空操作填充
+func (l *lockstepState) take(tick int, fill []int) map[int][]FrameData {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	frame := l.inputs[tick]
+	if frame == nil {
+		frame = map[int][]FrameData{}
+	}
+	for _, uid := range fill {
+		if _, ok := frame[uid]; !ok {
+			frame[uid] = []FrameData{}
+		}
+	}
+	delete(l.inputs, tick)
+	l.tick = tick
+	l.deadline = time.Time{}
+	l.stalled = false
+	return frame
+}
+
+// 参与帧同步的玩家（在线的房间用户）
+func (r *Room) activePlayers() []int {
+	var uids []int
+	for _, v := range r.users.List {
+		c := v.(*Client)
+		if c.isOnline() {
+			uids = append(uids, c.uid)
+		}
+	}
+	return uids
+}
+
+// 取出锁步帧的操作数据，并记录操作的等待时间
+func (r *Room) lockstepFrame(inputs map[int][]FrameData) map[int][]any {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	return r.frameInputs(inputs)
+}
+
+// 检查所有玩家是否已提交指定帧的操作，超时后按策略处理未提交的玩家，未能下发该帧时返回false。
+// 由帧调度器按帧间隔调用，玩家提交操作时会立即唤醒
+func (r *Room) pollLockstepFrame(l *lockstepState, tick int) (map[int][]any, bool) {
+	missing := l.missing(tick, r.activePlayers())
+	if len(missing) == 0 {
+		return r.lockstepFrame(l.take(tick, nil)), true
+	}
+	now := time.Now()
+	if l.deadline.IsZero() {
+		l.deadline = now.Add(l.timeout)
+	}
+	if now.Before(l.deadline) {
+		return nil, false
+	}
+	switch l.straggler {
+	case StragglerStall:
+		// 继续等待，直到玩家提交操作或离线
+		if !l.stalled {
+			logs.InfoM("锁步等待玩家操作超时，暂停下发：", missing, "房间ID:", r.id, "帧:", tick)
+			l.stalled = true
+		}
+		return nil, false
+	case StragglerDrop:
+		for _, uid := range missing {
+			logs.InfoM("锁步等待玩家操作超时，踢出房间：", uid, "房间ID:", r.id, "帧:", tick)
+			r.kickOut(uid)
+		}
+		return r.lockstepFrame(l.take(tick, nil)), true
+	default:
+		return r.lockstepFrame(l.take(tick, missing)), true
+	}
+}
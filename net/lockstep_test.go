@@ -0,0 +1,172 @@
+package net
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+	"websocket_server/util"
+)
+
+func TestFrameSyncOptionNormalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		option FrameSyncOption
+		want   FrameSyncOption
+	}{
+		{"默认为自由下发", FrameSyncOption{}, FrameSyncOption{Mode: FreeRun, Late: LateShift, IdleFold: defaultIdleFold}},
+		{"自由下发忽略锁步参数", FrameSyncOption{Mode: FreeRun, InputDelay: 3, Timeout: 100, Straggler: StragglerDrop},
+			FrameSyncOption{Mode: FreeRun, Late: LateShift, IdleFold: defaultIdleFold}},
+		{"锁步默认值", FrameSyncOption{Mode: Lockstep},
+			FrameSyncOption{Mode: Lockstep, Late: LateShift, IdleFold: defaultIdleFold, Timeout: defaultLockstepTimeout, Straggler: StragglerFill}},
+		{"锁步参数上限", FrameSyncOption{Mode: Lockstep, InputDelay: 100, Timeout: 100000, Straggler: StragglerStall, IdleFold: 100},
+			FrameSyncOption{Mode: Lockstep, Late: LateShift, IdleFold: maxIdleFold, InputDelay: maxInputDelay, Timeout: maxLockstepTimeout, Straggler: StragglerStall}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.option.normalize(); got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockstepPush(t *testing.T) {
+	l := newLockstepState(FrameSyncOption{Mode: Lockstep, InputDelay: 2}, 10)
+	tests := []struct {
+		uid  int
+		want int
+	}{
+		{1, 13}, // 最早在当前帧之后的第delay+1帧生效
+		{1, 14}, // 连续提交依次对应后续的帧
+		{2, 13},
+		{1, 15},
+	}
+	for i, tt := range tests {
+		got, err := l.push(tt.uid, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("push %d (uid %d) = %d, want %d", i, tt.uid, got, tt.want)
+		}
+	}
+	if missing := l.missing(13, []int{1, 2, 3}); !reflect.DeepEqual(missing, []int{3}) {
+		t.Errorf("missing(13) = %v, want [3]", missing)
+	}
+	frame := newLockstepTestRoom().frameInputs(l.take(13, nil))
+	if !reflect.DeepEqual(frame, map[int][]any{1: {0}, 2: {2}}) {
+		t.Errorf("take(13) = %v", frame)
+	}
+	if l.sent() != 13 {
+		t.Errorf("sent = %d, want 13", l.sent())
+	}
+	// 下发后新的提交不能早于已下发的帧
+	if got, _ := l.push(2, "x"); got != 16 {
+		t.Errorf("push after take = %d, want 16", got)
+	}
+}
+
+func TestLockstepPushAhead(t *testing.T) {
+	l := newLockstepState(FrameSyncOption{Mode: Lockstep}, 0)
+	for i := 0; i < maxInputAhead; i++ {
+		if _, err := l.push(1, i); err != nil {
+			t.Fatalf("push %d: %v", i, err)
+		}
+	}
+	if _, err := l.push(1, "ahead"); err == nil {
+		t.Fatal("push beyond maxInputAhead should fail")
+	}
+}
+
+// 输入延迟内的帧不需要等待玩家提交
+func TestLockstepMissingWithinDelay(t *testing.T) {
+	l := newLockstepState(FrameSyncOption{Mode: Lockstep, InputDelay: 2}, 0)
+	for tick := 1; tick <= 2; tick++ {
+		if missing := l.missing(tick, []int{1}); missing != nil {
+			t.Errorf("missing(%d) = %v, want none", tick, missing)
+		}
+	}
+	if missing := l.missing(3, []int{1}); len(missing) != 1 {
+		t.Errorf("missing(3) = %v, want [1]", missing)
+	}
+}
+
+// 创建只包含在线玩家的房间，用于锁步策略的测试
+func newLockstepTestRoom(uids ...int) *Room {
+	r := &Room{users: util.CreateArray(), spectators: util.CreateArray(), inputStats: map[int]*InputStats{}}
+	for _, uid := range uids {
+		c := &Client{uid: uid}
+		c.setConnected(true)
+		r.users.Push(c)
+	}
+	return r
+}
+
+func TestLockstepStraggler(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  StragglerPolicy
+		timeout time.Duration
+		inputs  map[int]any // 提交了第1帧操作的玩家
+		ok      bool
+		frame   map[int][]any
+		stalled bool
+	}{
+		{"全部提交", StragglerStall, time.Hour, map[int]any{1: "a", 2: "b"}, true, map[int][]any{1: {"a"}, 2: {"b"}}, false},
+		{"等待中", StragglerFill, time.Hour, map[int]any{1: "a"}, false, nil, false},
+		{"超时填充", StragglerFill, 0, map[int]any{1: "a"}, true, map[int][]any{1: {"a"}, 2: {}}, false},
+		{"超时暂停", StragglerStall, 0, map[int]any{1: "a"}, false, nil, true},
+		{"没有玩家提交时填充", StragglerFill, 0, nil, true, map[int][]any{1: {}, 2: {}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newLockstepTestRoom(1, 2)
+			l := newLockstepState(FrameSyncOption{Mode: Lockstep, Straggler: tt.policy}, 0)
+			l.timeout = tt.timeout
+			for uid, data := range tt.inputs {
+				l.push(uid, data)
+			}
+			frame, ok := r.pollLockstepFrame(l, 1)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && !reflect.DeepEqual(frame, tt.frame) {
+				t.Errorf("frame = %v, want %v", frame, tt.frame)
+			}
+			if l.stalled != tt.stalled {
+				t.Errorf("stalled = %v, want %v", l.stalled, tt.stalled)
+			}
+			wantSent := 0
+			if ok {
+				wantSent = 1
+			}
+			if l.sent() != wantSent {
+				t.Errorf("sent = %d, want %d", l.sent(), wantSent)
+			}
+		})
+	}
+}
+
+// 锁步drop策略：超时未提交操作的玩家被踢出房间，其他玩家继续收到帧
+func TestLockstepStragglerDrop(t *testing.T) {
+	tcpAddr, wsURL := startTestServer(t)
+	a := dialTCP(t, tcpAddr)
+	b := dialWS(t, wsURL)
+	uid := a.login("lockstep", testOpenId("a"))
+	b.login("lockstep", testOpenId("b"))
+	a.write(&ClientMessage{Op: CreateRoom, Id: 2, Data: map[string]any{"fps": 50}})
+	roomId := a.reply(2).Data.(map[string]any)["id"]
+	b.write(&ClientMessage{Op: JoinRoom, Id: 3, Data: map[string]any{"id": roomId}})
+	b.reply(3)
+	a.write(&ClientMessage{Op: StartFrameSync, Id: 4, Data: map[string]any{"mode": "lockstep", "timeout": 100, "straggler": "drop"}})
+	a.reply(4)
+	a.write(&ClientMessage{Op: UploadFrame, Data: "move"})
+	b.readUntil(func(m *ClientMessage) bool { return m.Op == SelfKickOut })
+	frame := a.readUntil(func(m *ClientMessage) bool { return m.Op == FData })
+	d := frame.Data.(map[string]any)
+	want := map[string]any{fmt.Sprint(uid): []any{"move"}}
+	if d["t"] != float64(1) || !reflect.DeepEqual(d["d"], want) {
+		t.Fatalf("FData = %v, want t=1 d=%v", d, want)
+	}
+}
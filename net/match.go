@@ -25,10 +25,10 @@ type MatchRange struct {
 
 // 房间、玩家之间匹配可选参数，匹配参数会跟用户的data参数进行匹配
 type MatchOption struct {
-	Key    string                `json:"key"`    // 匹配key，字符串比较，当为一样的时候，则对匹配，如果为空字符串时，则忽略此匹配
-	Number int                   `json:"number"` // 匹配所需的总人数
-	Range  map[string]MatchRange `json:"range"`  // 匹配参数的最小值，到最大值
-	FPS    float64               `json:"fps"`    // 帧同步帧率，0使用默认值30。匹配时不同FPS不会配对在一起
+	Key    string                `json:"key,omitempty"`                  // 匹配key，字符串比较，当为一样的时候，则对匹配，如果为空字符串时，则忽略此匹配
+	Number int                   `json:"number"`                         // 匹配所需的总人数
+	Range  map[string]MatchRange `json:"range,omitempty"`                // 匹配参数的最小值，到最大值
+	FPS    float64               `json:"fps,omitempty" validate:"min=0"` // 帧同步帧率，0使用默认值30。匹配时不同FPS不会配对在一起
 }
 
 // 匹配用户
@@ -0,0 +1,321 @@
+package net
+
+// 各op的data结构定义，字段名与JSON保持一致，用于严格解析请求（见validate.go）与生成协议描述（schema）
+
+// ===== 请求 =====
+
+// 登陆
+type LoginRequest struct {
+	OpenId   string `json:"openid" validate:"nonempty"` // 用户唯一标识
+	Username string `json:"username"`                   // 用户名称
+	AppId    string `json:"appid" validate:"nonempty"`  // 应用ID，不同应用的用户互不影响
+	Batch    bool   `json:"batch,omitempty"`            // 是否开启消息合并下发（开启后可能收到Batch消息）
+	Compact  bool   `json:"compact,omitempty"`          // 是否开启紧凑帧数据（合并连续的空帧、省略重复的操作）
+	NoAck    bool   `json:"noAck,omitempty"`            // 上传帧数据时不回复确认，只在出错时回复错误
+	Version  int    `json:"version,omitempty"`          // 客户端支持的协议版本，不传时为1
+}
+
+// 恢复会话
+type ResumeSessionRequest struct {
+	AppId   string `json:"appid" validate:"nonempty"`   // 应用ID，需要与登录时的应用一致
+	Session string `json:"session" validate:"nonempty"` // 登录时返回的会话Token
+	Seq     int    `json:"seq" validate:"min=0"`        // 连续收到的最大消息序号（该序号及之前的消息均已收到）
+}
+
+// 创建房间
+type CreateRoomRequest struct {
+	FPS            float64          `json:"fps,omitempty" validate:"min=0"`                      // 帧同步帧率，0使用默认值30
+	FrameSync      *FrameSyncOption `json:"frameSync,omitempty"`                                 // 帧同步模式，不传时为自由下发
+	Record         bool             `json:"record,omitempty"`                                    // 是否录制帧同步回放（服务器需开启回放目录）
+	MaxSpectators  *int             `json:"maxSpectators,omitempty" validate:"min=0,max=100"`    // 观战人数上限，0表示不允许观战，不传时为10
+	SpectatorDelay *int             `json:"spectatorDelay,omitempty" validate:"min=0,max=60000"` // 观战延迟（毫秒），不传时为3000
+	Validator      string           `json:"validator,omitempty"`                                 // 帧操作校验器（服务器注册的扩展名称），不传时不校验
+	MaxInputs      int              `json:"maxInputs,omitempty" validate:"min=0,max=64"`         // 每个玩家每帧最多提交的操作数，0使用默认值8
+	MaxInputSize   int              `json:"maxInputSize,omitempty" validate:"min=0,max=65536"`   // 单个操作的最大字节数（按JSON编码后计算），0使用默认值1024
+}
+
+// 加入房间
+type JoinRoomRequest struct {
+	Id       int    `json:"id" validate:"min=1"` // 房间ID
+	Password string `json:"password,omitempty"`  // 房间密码
+	Spectate bool   `json:"spectate,omitempty"`  // 是否以观战身份加入（不受房间锁定与人数限制）
+}
+
+// 更换座位
+type SwitchSeatRequest struct {
+	Seat int `json:"seat" validate:"min=1"` // 目标座位号（1~房间最大人数）
+}
+
+// 发送消息给用户
+type SendToUserRequest struct {
+	Uid  int `json:"uid" validate:"min=1"` // 目标用户ID
+	Data any `json:"data"`                 // 消息内容
+}
+
+// 更新房间配置
+type UpdateRoomOptionRequest struct {
+	MaxCounts      int    `json:"maxCounts,omitempty" validate:"min=0,max=100"`        // 最大人数
+	Password       string `json:"password,omitempty"`                                  // 房间密码，为空时取消密码
+	MaxSpectators  *int   `json:"maxSpectators,omitempty" validate:"min=0,max=100"`    // 观战人数上限，不传时不修改
+	SpectatorDelay *int   `json:"spectatorDelay,omitempty" validate:"min=0,max=60000"` // 观战延迟（毫秒），不传时不修改
+}
+
+// 指定用户
+type UidRequest struct {
+	Uid int `json:"uid" validate:"min=1"` // 用户ID
+}
+
+// 获取指定帧范围的帧事件
+type GetFrameAtRequest struct {
+	Start int `json:"start,omitempty" validate:"min=0"` // 开始位置（第start+1帧）
+	End   int `json:"end,omitempty" validate:"min=0"`   // 结束位置（不包含），0表示获取一页（最多maxFramePage帧）
+}
+
+// 获取房间列表
+type GetRoomListRequest struct {
+	Page   int `json:"page,omitempty" validate:"min=0"`   // 页码，从0开始
+	Counts int `json:"counts,omitempty" validate:"min=0"` // 每页数量
+}
+
+// 侦听服务器通知
+type ListenerRequest struct {
+	Op ClientAction `json:"op,omitempty"` // 侦听的op，0表示EVENT_GetServerMsg
+}
+
+// 获取全服历史消息
+type GetServerOldMsgRequest struct {
+	Counts int `json:"counts" validate:"min=1"` // 获取的消息数量
+}
+
+// 调用扩展方法
+type ExtendsCallRequest struct {
+	F string `json:"f" validate:"nonempty"` // 扩展方法名称（类型名.方法名）
+	D any    `json:"d,omitempty"`           // 扩展方法参数
+}
+
+// 查询房间列表
+type QueryRoomListRequest struct {
+	RoomIds []int `json:"roomids"` // 房间ID列表
+}
+
+// 上报帧校验值
+type ReportChecksumRequest struct {
+	T        int    `json:"t" validate:"min=1"`           // 帧序号（与FData的t一致）
+	Checksum string `json:"checksum" validate:"nonempty"` // 该帧的状态校验值
+}
+
+// 追帧
+type FrameCatchUpRequest struct {
+	From int `json:"from,omitempty" validate:"min=0"` // 开始帧，0表示从第1帧开始
+}
+
+// 播放回放
+type PlayReplayRequest struct {
+	Id    string `json:"id" validate:"nonempty"`                 // 回放ID
+	Speed int    `json:"speed,omitempty" validate:"oneof=1 2 4"` // 播放速度，默认为1
+	From  int    `json:"from,omitempty" validate:"min=0"`        // 快进到的帧，0表示从头播放
+}
+
+// 控制回放
+type ReplayControlRequest struct {
+	Action string `json:"action" validate:"oneof=pause resume seek speed stop"` // 控制操作
+	T      int    `json:"t,omitempty" validate:"min=0"`                         // seek跳转到的帧（跳转后已播放到该帧）
+	Speed  int    `json:"speed,omitempty" validate:"oneof=1 2 4"`               // speed修改的播放速度
+}
+
+// 时间同步
+type TimeSyncRequest struct {
+	C int64 `json:"c,omitempty"` // 客户端发送时的时间，原样返回
+}
+
+// 修改帧率
+type ChangeFrameRateRequest struct {
+	FPS float64 `json:"fps" validate:"min=1,max=120"`  // 新的帧率
+	At  int     `json:"at,omitempty" validate:"min=0"` // 生效的帧序号，不传时为下一帧之后的一帧
+}
+
+// 心跳回复
+type PongRequest struct {
+	S int64 `json:"s" validate:"min=1"` // Ping中的服务器单调时间
+}
+
+// ===== 回复与事件 =====
+
+// 登陆回复
+type LoginReply struct {
+	Uid      int            `json:"uid"`               // 用户ID
+	Session  string         `json:"session,omitempty"` // 会话Token，用于断线后恢复会话（未开启时不返回）
+	Version  int            `json:"version"`           // 协商的协议版本
+	Ops      []int          `json:"ops"`               // 当前协议版本可调用的op
+	Features []string       `json:"features"`          // 已开启的可选功能
+	Limits   ProtocolLimits `json:"limits"`            // 服务器的限制参数
+}
+
+// 服务器的限制参数
+type ProtocolLimits struct {
+	MaxMessageSize int     `json:"maxMessageSize"` // 单条消息的最大字节数
+	MinFps         float64 `json:"minFps"`         // 最低帧率
+	MaxFps         float64 `json:"maxFps"`         // 最高帧率
+	DefaultFps     float64 `json:"defaultFps"`     // 默认帧率
+	MaxRoomCounts  int     `json:"maxRoomCounts"`  // 房间人数上限
+	MaxSpectators  int     `json:"maxSpectators"`  // 观战人数上限
+	MaxFramePage   int     `json:"maxFramePage"`   // GetFrameAt每次最多获取的帧数
+}
+
+// 上传帧同步数据的回复
+type UploadFrameReply struct {
+	T int `json:"t,omitempty"` // 该操作生效的帧序号
+}
+
+// 房间ID
+type RoomIdReply struct {
+	Id int `json:"id"` // 房间ID
+}
+
+// 房间内的用户数据
+type UserData struct {
+	Uid       int            `json:"uid"`       // 用户ID
+	Name      string         `json:"name"`      // 用户名称
+	Seat      int            `json:"seat"`      // 座位号（0=未分配）
+	Spectator bool           `json:"spectator"` // 是否为观战用户
+	Data      map[string]any `json:"data"`      // 用户自定义数据
+	Dropped   int64          `json:"dropped"`   // 连接累计丢弃的消息数量
+	Rtt       int            `json:"rtt"`       // 往返延迟（毫秒），0表示尚未测量
+}
+
+// 携带发送者uid的数据
+type UserPayload struct {
+	Uid  int `json:"uid"`  // 发送者用户ID
+	Data any `json:"data"` // 数据内容
+}
+
+// 房间信息
+type RoomData struct {
+	Id            int                    `json:"id"`            // 房间ID
+	Master        UserData               `json:"master"`        // 房主
+	Users         []UserData             `json:"users"`         // 房间内的用户
+	Seats         map[int]int            `json:"seats"`         // 座位号 -> uid
+	Max           int                    `json:"max"`           // 最大人数
+	Spectators    []UserData             `json:"spectators"`    // 观战用户
+	MaxSpectators int                    `json:"maxSpectators"` // 观战人数上限
+	FPS           float64                `json:"fps"`           // 当前帧率
+	RateChanges   []*FrameRateEvent      `json:"rateChanges"`   // 本局帧同步已公布的帧率变更
+	InputStats    map[int]InputStats     `json:"inputStats"`    // uid -> 本局帧同步的操作到达统计
+	Validator     string                 `json:"validator"`     // 帧操作校验器，为空时不校验
+	MaxInputs     int                    `json:"maxInputs"`     // 每个玩家每帧最多提交的操作数
+	MaxInputSize  int                    `json:"maxInputSize"`  // 单个操作的最大字节数
+	Data          map[string]any         `json:"data"`          // 房间自定义数据
+	State         map[string]any         `json:"state"`         // 房间状态
+	UsersState    map[int]map[string]any `json:"usersState"`    // uid -> 用户状态
+}
+
+// 帧数据
+type FrameEvent struct {
+	T int           `json:"t"`           // 帧序号
+	D map[int][]any `json:"d"`           // uid -> 该帧的操作列表
+	N int           `json:"n,omitempty"` // 紧凑模式：本条消息包含第t-n+1~t帧，其中t之前的帧均为空帧，不传时为1
+	R []int         `json:"r,omitempty"` // 紧凑模式：操作与上一帧（第t-1帧）相同的uid
+}
+
+// 房间历史消息
+type RoomOldMessageReply struct {
+	List []ClientMessage `json:"list"` // 历史消息
+}
+
+// 房间列表
+type RoomListReply struct {
+	OnlineCounts int        `json:"onlineCounts"` // 在线人数
+	List         []RoomInfo `json:"list"`         // 房间列表
+}
+
+// 查询房间列表
+type QueryRoomListReply struct {
+	List []RoomInfo `json:"list"` // 房间列表
+}
+
+// 通过UID获取的用户数据
+type UserDataByUidReply struct {
+	Uid  int    `json:"uid"`  // 用户ID
+	Name string `json:"name"` // 用户名称
+	Data any    `json:"data"` // 用户自定义数据
+}
+
+// 座位更新
+type SeatUpdateEvent struct {
+	Uid     int `json:"uid"`     // 用户ID
+	OldSeat int `json:"oldSeat"` // 原座位号
+	NewSeat int `json:"newSeat"` // 新座位号
+}
+
+// 房间列表变更
+type RoomListChangedEvent struct {
+	Type string `json:"type"` // 变更类型
+}
+
+// 状态不同步通知
+type DesyncEvent struct {
+	T         int            `json:"t"`         // 帧序号
+	Uids      []int          `json:"uids"`      // 校验值与多数玩家不一致的用户（数量相同时为所有用户）
+	Checksums map[int]string `json:"checksums"` // uid -> 上报的校验值
+}
+
+// 追帧信息
+type CatchUpInfo struct {
+	From int `json:"from"` // 开始帧
+	T    int `json:"t"`    // 开始追帧时的当前帧
+}
+
+// 追帧分片
+type CatchUpChunkEvent struct {
+	From int    `json:"from"` // 分片的开始帧
+	To   int    `json:"to"`   // 分片的结束帧（包含）
+	T    int    `json:"t"`    // 房间的当前帧
+	Last bool   `json:"last"` // 是否为最后一个分片，之后的帧通过FData下发
+	Z    []byte `json:"z"`    // gzip压缩的帧数据JSON：[{t: 帧序号, d: uid -> 操作列表}]（JSON编码时为base64）
+}
+
+// 时间同步的回复
+type TimeSyncReply struct {
+	C        int64   `json:"c"`        // 请求中的客户端时间
+	Wall     int64   `json:"wall"`     // 服务器时间（Unix毫秒）
+	Mono     int64   `json:"mono"`     // 服务器单调时间（服务器启动后的毫秒数）
+	T        int     `json:"t"`        // 房间当前帧，不在帧同步中时为0
+	Interval float64 `json:"interval"` // 帧间隔（毫秒），不在帧同步中时为0
+	Rtt      int     `json:"rtt"`      // 服务器测量的往返延迟（毫秒），0表示尚未测量
+}
+
+// 应用层心跳
+type PingEvent struct {
+	S int64 `json:"s"` // 服务器单调时间，Pong时原样返回
+}
+
+// 回放信息
+type ReplayMeta struct {
+	Id          string            `json:"id"`                    // 回放ID
+	RoomId      int               `json:"roomId"`                // 房间ID
+	FPS         float64           `json:"fps"`                   // 帧率
+	FrameSync   FrameSyncOption   `json:"frameSync"`             // 帧同步模式
+	StartTime   int64             `json:"startTime"`             // 开始帧同步的时间（Unix毫秒）
+	EndTime     int64             `json:"endTime"`               // 停止帧同步的时间（Unix毫秒）
+	Master      int               `json:"master"`                // 房主uid
+	Users       []ReplayUser      `json:"users"`                 // 房间内的用户
+	Seats       map[int]int       `json:"seats"`                 // 座位号 -> uid
+	CustomData  map[string]any    `json:"customData"`            // 房间自定义数据
+	Frames      int               `json:"frames"`                // 总帧数
+	RateChanges []*FrameRateEvent `json:"rateChanges,omitempty"` // 帧率变更（fps为开始时的帧率）
+}
+
+// 回放状态
+type ReplayStateEvent struct {
+	Id    string `json:"id"`    // 回放ID
+	State string `json:"state"` // 状态：playing、paused、reset（向前跳转，需重置到第0帧）、ended、stopped
+	T     int    `json:"t"`     // 已播放的帧
+	Total int    `json:"total"` // 总帧数
+	Speed int    `json:"speed"` // 播放速度
+}
+
+// 消息丢弃通知
+type MessagesDroppedEvent struct {
+	Count int   `json:"count"` // 本次通知丢弃的消息数量
+	Total int64 `json:"total"` // 连接累计丢弃的消息数量
+}
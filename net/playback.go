@@ -0,0 +1,268 @@
+package net
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+	"websocket_server/runtime"
+)
+
+// 回放播放状态
+const (
+	PlaybackPlaying = "playing" // 播放中
+	PlaybackPaused  = "paused"  // 已暂停
+	PlaybackReset   = "reset"   // 向前跳转，客户端需要重置到第0帧，服务器随后快进到目标帧
+	PlaybackEnded   = "ended"   // 已播放到最后一帧（仍可跳转）
+	PlaybackStopped = "stopped" // 已停止
+)
+
+// 回放快进时每批发送的帧数，批次之间间隔catchUpInterval，避免发送通道积压
+const playbackFastBatch = 64
+
+// 回放播放器，按原始帧间隔把回放中的帧以FData下发给客户端
+type replayPlayer struct {
+	mu       sync.Mutex
+	id       string
+	replay   *Replay
+	next     int           // 下一个要发送的帧序号
+	fastTo   int           // 快进的目标帧（不包含），小于该帧的帧不等待直接发送
+	speed    int           // 播放速度：1、2、4
+	paused   bool          // 是否暂停
+	stopped  bool          // 是否已停止
+	wake     chan struct{} // 控制操作通知播放协程
+	interval time.Duration // 没有帧时间时使用的帧间隔
+}
+
+// 打开回放并开始播放，已在播放的回放会先停止
+func (c *Client) playReplay(id string, speed int, from int) (*ReplayMeta, error) {
+	if c.room != nil && c.room.frameSync {
+		return nil, fmt.Errorf("房间帧同步中，无法播放回放")
+	}
+	path := replayPath(CurrentServer.ReplayDir, id)
+	if path == "" {
+		return nil, fmt.Errorf("回放不存在")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	replay, err := ReadReplay(f)
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+	// 只能播放同一应用的回放
+	if replay.AppId != c.appid {
+		return nil, fmt.Errorf("回放不存在")
+	}
+	if from > len(replay.Frames) {
+		return nil, fmt.Errorf("帧范围无效，有效范围为0~%d", len(replay.Frames))
+	}
+	if speed == 0 {
+		speed = 1
+	}
+	fps := replay.FPS
+	if fps <= 0 {
+		fps = defaultFPS
+	}
+	p := &replayPlayer{
+		id:       id,
+		replay:   replay,
+		next:     1,
+		fastTo:   from,
+		speed:    speed,
+		wake:     make(chan struct{}, 1),
+		interval: time.Duration(float64(time.Second) / fps),
+	}
+	c.stopPlayback()
+	c.playback = p
+	meta := &ReplayMeta{
+		Id:          id,
+		RoomId:      replay.RoomId,
+		FPS:         replay.FPS,
+		FrameSync:   replay.FrameSync,
+		StartTime:   replay.StartTime,
+		EndTime:     replay.EndTime,
+		Master:      replay.Master,
+		Users:       replay.Users,
+		Seats:       replay.Seats,
+		CustomData:  replay.CustomData,
+		Frames:      len(replay.Frames),
+		RateChanges: replay.RateChanges,
+	}
+	go c.runPlayback(p)
+	return meta, nil
+}
+
+// 停止正在播放的回放
+func (c *Client) stopPlayback() {
+	p := c.playback
+	if p == nil {
+		return
+	}
+	c.playback = nil
+	p.control(func() {
+		p.stopped = true
+	})
+}
+
+// 修改播放状态并通知播放协程
+func (p *replayPlayer) control(f func()) {
+	p.mu.Lock()
+	f()
+	p.mu.Unlock()
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// 播放状态
+func (p *replayPlayer) state(state string) *ReplayStateEvent {
+	return &ReplayStateEvent{
+		Id:    p.id,
+		State: state,
+		T:     p.next - 1,
+		Total: len(p.replay.Frames),
+		Speed: p.speed,
+	}
+}
+
+// 控制回放：pause、resume、seek、speed
+func (c *Client) controlPlayback(action string, tick int, speed int) (*ReplayStateEvent, error) {
+	p := c.playback
+	if p == nil {
+		return nil, fmt.Errorf("没有正在播放的回放")
+	}
+	var state *ReplayStateEvent
+	var err error
+	p.control(func() {
+		switch action {
+		case "pause":
+			p.paused = true
+		case "resume":
+			p.paused = false
+		case "speed":
+			if speed == 0 {
+				err = fmt.Errorf("需要提供speed")
+				return
+			}
+			p.speed = speed
+		case "seek":
+			if tick > len(p.replay.Frames) {
+				err = fmt.Errorf("帧范围无效，有效范围为0~%d", len(p.replay.Frames))
+				return
+			}
+			if tick < p.next-1 {
+				// 向前跳转：客户端重置后从第1帧快进
+				c.SendToUserOp(&ClientMessage{
+					Op:   ReplayState,
+					Data: &ReplayStateEvent{Id: p.id, State: PlaybackReset, T: 0, Total: len(p.replay.Frames), Speed: p.speed},
+				})
+				p.next = 1
+			}
+			p.fastTo = tick + 1
+		}
+		s := PlaybackPlaying
+		if p.paused {
+			s = PlaybackPaused
+		}
+		state = p.state(s)
+		if action == "seek" {
+			// 跳转后的帧由播放协程快进下发
+			state.T = tick
+		}
+	})
+	return state, err
+}
+
+// 播放协程
+func (c *Client) runPlayback(p *replayPlayer) {
+	defer runtime.GoRecover()
+	c.SendToUserOp(&ClientMessage{
+		Op:   FrameSyncReady,
+		Data: p.replay.FrameSync,
+	})
+	var due time.Time // 下一帧的发送时间
+	sent := 0         // 快进时当前批次已发送的帧数
+	ended := false
+	for {
+		p.mu.Lock()
+		if p.stopped {
+			p.mu.Unlock()
+			return
+		}
+		frames := p.replay.Frames
+		wait := time.Duration(0)
+		switch {
+		case !c.isConnected():
+			// 连接断开（等待恢复会话）时不发送，恢复后重新计时
+			wait = time.Second
+			due = time.Time{}
+		case p.next < p.fastTo && p.next <= len(frames):
+			// 快进时分批发送（暂停时也会快进到跳转的目标帧）
+			if sent >= playbackFastBatch {
+				sent = 0
+				wait = catchUpInterval
+			}
+			due = time.Time{}
+		case p.paused:
+			wait = time.Second
+			due = time.Time{}
+		case p.next > len(frames):
+			if !ended {
+				ended = true
+				c.SendToUserOp(&ClientMessage{
+					Op:   ReplayState,
+					Data: p.state(PlaybackEnded),
+				})
+			}
+			wait = time.Second
+		default:
+			if due.IsZero() {
+				due = time.Now().Add(p.frameDelay())
+			}
+			wait = time.Until(due)
+		}
+		if wait <= 0 {
+			frame := frames[p.next-1]
+			p.next++
+			sent++
+			ended = false
+			if !due.IsZero() {
+				due = due.Add(p.frameDelay())
+			}
+			// 在锁内发送，保证与跳转时的reset通知顺序一致
+			c.SendToUserOp(&ClientMessage{
+				Op: FData,
+				Data: map[string]any{
+					"t": frame.T,
+					"d": frame.D,
+				},
+			})
+			p.mu.Unlock()
+			continue
+		}
+		p.mu.Unlock()
+		// 等待期间收到控制操作时立即重新计算
+		timer := time.NewTimer(wait)
+		select {
+		case <-p.wake:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// 下一帧与上一帧的间隔（按播放速度缩放），优先使用录制时的帧时间
+func (p *replayPlayer) frameDelay() time.Duration {
+	frames := p.replay.Frames
+	delay := p.interval
+	if p.next > 1 && p.next <= len(frames) {
+		if d := frames[p.next-1].Time - frames[p.next-2].Time; d > 0 {
+			delay = time.Duration(d) * time.Millisecond
+		}
+	}
+	return delay / time.Duration(p.speed)
+}
@@ -0,0 +1,152 @@
+package net
+
+import (
+	"sort"
+	"websocket_server/websocketv2"
+)
+
+// 当前服务器的协议版本，新增op或调整协议时递增
+const ProtocolVersion = 7
+
+// 房间参数的取值范围
+const (
+	defaultFPS        = 30  // 默认帧率
+	minFPS            = 1   // 最低帧率
+	maxFPS            = 120 // 最高帧率
+	defaultRoomCounts = 10  // 默认房间人数
+	maxRoomCounts     = 100 // 房间人数上限
+	// 观战参数
+	defaultSpectatorCounts = 10    // 默认观战人数上限
+	maxSpectatorCounts     = 100   // 观战人数上限
+	defaultSpectatorDelay  = 3000  // 默认观战延迟（毫秒）
+	maxSpectatorDelay      = 60000 // 最大观战延迟（毫秒）
+	maxFramePage           = 1000  // GetFrameAt每次最多获取的帧数
+)
+
+// 客户端可调用的op，以及需要的最低协议版本（未声明版本的客户端视为版本1）
+var opVersions = map[ClientAction]int{
+	Login:                      1,
+	Message:                    1,
+	CreateRoom:                 1,
+	GetRoomData:                1,
+	JoinRoom:                   1,
+	ExitRoom:                   1,
+	StartFrameSync:             1,
+	StopFrameSync:              1,
+	StopFrameSyncWithoutUnlock: 1,
+	UploadFrame:                1,
+	SendToUser:                 1,
+	RoomMessage:                1,
+	MatchUser:                  1,
+	CannelMatchUser:            1,
+	UpdateUserData:             1,
+	GetRoomOldMessage:          1,
+	LockRoom:                   1,
+	UnlockRoom:                 1,
+	UpdateRoomCustomData:       1,
+	UpdateRoomOption:           1,
+	KickOut:                    1,
+	GetFrameAt:                 1,
+	SetRoomState:               1,
+	SetClientState:             1,
+	ResetRoom:                  1,
+	SetRoomMatchOption:         1,
+	MatchRoom:                  1,
+	GetRoomList:                1,
+	SendServerMsg:              1,
+	ListenerServer:             1,
+	CannelListenerServer:       1,
+	GetUserDataByUID:           1,
+	GetServerOldMsg:            1,
+	ExtendsCall:                1,
+	QueryRoomList:              1,
+	SwitchSeat:                 1,
+	ResumeSession:              2,
+	ReportChecksum:             3,
+	FrameCatchUp:               4,
+	PlayReplay:                 5,
+	ReplayControl:              5,
+	TimeSync:                   6,
+	Pong:                       6,
+	ChangeFrameRate:            7,
+}
+
+// 协商协议版本：客户端未声明时为1，高于服务器版本时使用服务器版本
+func negotiateVersion(version int) int {
+	if version <= 0 {
+		return 1
+	}
+	if version > ProtocolVersion {
+		return ProtocolVersion
+	}
+	return version
+}
+
+// 指定协议版本下是否可以调用该op
+func opAllowed(op ClientAction, version int) bool {
+	v, ok := opVersions[op]
+	return ok && v <= version
+}
+
+// 指定协议版本下可调用的op列表
+func supportedOps(version int) []int {
+	ops := []int{}
+	for op, v := range opVersions {
+		if v <= version {
+			ops = append(ops, int(op))
+		}
+	}
+	sort.Ints(ops)
+	return ops
+}
+
+// 当前连接已开启的可选功能
+func (c *Client) features() []string {
+	option := c.getApp().option
+	features := []string{"codec:" + c.codec.Name()}
+	if option.Compression {
+		features = append(features, "compression")
+	}
+	if c.session != nil {
+		features = append(features, "resume")
+	}
+	if c.batch {
+		features = append(features, "batch")
+	}
+	if c.compact {
+		features = append(features, "compact")
+	}
+	if c.noAck {
+		features = append(features, "noAck")
+	}
+	// 开启可恢复会话时消息按序号顺序发送，不使用优先通道
+	if option.PriorityLanes {
+		features = append(features, "priorityLanes")
+	}
+	if option.PingInterval > 0 {
+		features = append(features, "ping")
+	}
+	return features
+}
+
+// 服务器的限制参数
+func protocolLimits() ProtocolLimits {
+	return ProtocolLimits{
+		MaxMessageSize: websocketv2.MaxMessageSize,
+		MinFps:         minFPS,
+		MaxFps:         maxFPS,
+		DefaultFps:     defaultFPS,
+		MaxRoomCounts:  maxRoomCounts,
+		MaxSpectators:  maxSpectatorCounts,
+		MaxFramePage:   maxFramePage,
+	}
+}
+
+// 登录回复中的协议信息：协商的版本、可调用的op、已开启的功能以及限制参数
+func (c *Client) protocolInfo(reply map[string]any) map[string]any {
+	reply["version"] = c.version
+	reply["ops"] = supportedOps(c.version)
+	reply["features"] = c.features()
+	reply["limits"] = protocolLimits()
+	return reply
+}
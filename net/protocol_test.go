@@ -0,0 +1,56 @@
+package net
+
+import "testing"
+
+func TestNegotiateVersion(t *testing.T) {
+	tests := []struct {
+		client int
+		want   int
+	}{
+		{0, 1},
+		{-1, 1},
+		{1, 1},
+		{3, 3},
+		{ProtocolVersion, ProtocolVersion},
+		{ProtocolVersion + 1, ProtocolVersion},
+	}
+	for _, tt := range tests {
+		if got := negotiateVersion(tt.client); got != tt.want {
+			t.Errorf("negotiateVersion(%d) = %d, want %d", tt.client, got, tt.want)
+		}
+	}
+}
+
+func TestOpAllowed(t *testing.T) {
+	tests := []struct {
+		op      ClientAction
+		version int
+		want    bool
+	}{
+		{CreateRoom, 1, true},
+		{ResumeSession, 1, false},
+		{ResumeSession, 2, true},
+		{ReportChecksum, 2, false},
+		{ReportChecksum, 3, true},
+		{FrameCatchUp, 3, false},
+		{FrameCatchUp, 4, true},
+		{PlayReplay, 4, false},
+		{ReplayControl, 5, true},
+		{TimeSync, 5, false},
+		{Pong, 6, true},
+		{ChangeFrameRate, 6, false},
+		{ChangeFrameRate, 7, true},
+		{FData, ProtocolVersion, false}, // 服务器下发的op不能由客户端调用
+	}
+	for _, tt := range tests {
+		if got := opAllowed(tt.op, tt.version); got != tt.want {
+			t.Errorf("opAllowed(%d, %d) = %v, want %v", tt.op, tt.version, got, tt.want)
+		}
+	}
+	// 新增的op不能高于服务器的协议版本，否则任何客户端都无法调用
+	for op, v := range opVersions {
+		if v > ProtocolVersion {
+			t.Errorf("op %d需要协议版本%d，高于服务器版本%d", op, v, ProtocolVersion)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package net
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"websocket_server/logs"
+	"websocket_server/runtime"
+
+	"github.com/gin-gonic/gin"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 回放文件格式：4字节魔数`HXRP` + 2字节大端格式版本 + 2字节大端应用ID长度 + 应用ID + gzip压缩的回放JSON（Replay）。
+// 版本1没有应用ID字段
+const (
+	replayMagic   = "HXRP"
+	ReplayVersion = 2
+	replayExt     = ".hxrp"
+)
+
+// 回放ID只允许字母、数字、下划线、短横线，防止路径穿越
+var (
+	replayIdPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	replayUnsafe    = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+)
+
+// 帧同步回放
+type Replay struct {
+	Version     int               `json:"version"`               // 回放格式版本
+	AppId       string            `json:"appid"`                 // 应用ID
+	RoomId      int               `json:"roomId"`                // 房间ID
+	FPS         float64           `json:"fps"`                   // 帧率
+	FrameSync   FrameSyncOption   `json:"frameSync"`             // 帧同步模式
+	StartTime   int64             `json:"startTime"`             // 开始帧同步的时间（Unix毫秒）
+	EndTime     int64             `json:"endTime"`               // 停止帧同步的时间（Unix毫秒）
+	Master      int               `json:"master"`                // 房主uid
+	Users       []ReplayUser      `json:"users"`                 // 停止帧同步时房间内的用户
+	Seats       map[int]int       `json:"seats"`                 // 座位号 -> uid
+	CustomData  map[string]any    `json:"customData"`            // 房间自定义数据
+	Frames      []ReplayFrame     `json:"frames"`                // 所有帧
+	Desyncs     []*DesyncEvent    `json:"desyncs"`               // 检测到的状态不同步
+	RateChanges []*FrameRateEvent `json:"rateChanges,omitempty"` // 帧率变更（fps为开始时的帧率）
+}
+
+// 回放中的用户
+type ReplayUser struct {
+	Uid  int            `json:"uid"`  // 用户ID
+	Name string         `json:"name"` // 用户名称
+	Seat int            `json:"seat"` // 座位号
+	Data map[string]any `json:"data"` // 用户自定义数据
+}
+
+// 回放中的帧
+type ReplayFrame struct {
+	T    int           `json:"t"`    // 帧序号
+	Time int64         `json:"time"` // 相对开始帧同步时间的毫秒数
+	D    map[int][]any `json:"d"`    // uid -> 该帧的操作列表
+}
+
+// 回放文件信息
+type ReplayInfo struct {
+	Id   string `json:"id"`   // 回放ID（文件名）
+	Size int64  `json:"size"` // 文件字节数
+	Time int64  `json:"time"` // 写入时间（Unix毫秒）
+}
+
+// 生成本局帧同步的回放（不包含帧数据），需要在帧锁内、重置帧序号之前调用。
+// 返回的load在帧锁外读取帧数据（溢出到磁盘的帧需要解压）
+func (r *Room) buildReplay() (replay *Replay, load func()) {
+	replay = &Replay{
+		Version:    ReplayVersion,
+		AppId:      r.master.appid,
+		RoomId:     r.id,
+		FPS:        float64(time.Second) / float64(r.interval),
+		FrameSync:  r.option.frameSync.normalize(),
+		StartTime:  r.syncStart.UnixMilli(),
+		EndTime:    time.Now().UnixMilli(),
+		Master:     r.master.uid,
+		Seats:      map[int]int{},
+		CustomData: r.customData.Copy(),
+		Frames:     make([]ReplayFrame, 0, r.cacheId),
+	}
+	for _, v := range r.users.List {
+		c := v.(*Client)
+		replay.Users = append(replay.Users, ReplayUser{Uid: c.uid, Name: c.name, Seat: c.seat, Data: c.userData.Copy()})
+		if c.seat > 0 {
+			replay.Seats[c.seat] = c.uid
+		}
+	}
+	for _, v := range r.desyncs.List {
+		replay.Desyncs = append(replay.Desyncs, v.(*DesyncEvent))
+	}
+	// 只记录已生效的帧率变更，回放的帧率为开始时的帧率
+	for _, v := range r.rateChanges {
+		if v.At <= r.cacheId {
+			replay.RateChanges = append(replay.RateChanges, v)
+		}
+	}
+	if len(replay.RateChanges) > 0 {
+		replay.FPS = replay.RateChanges[0].Prev
+	}
+	h := r.history.snapshot()
+	return replay, func() { loadReplayFrames(replay, h) }
+}
+
+// 从帧历史快照读取本局的所有帧，读取完成后关闭快照
+func loadReplayFrames(replay *Replay, h *frameHistory) {
+	defer h.release()
+	// 已被清除（discard策略）的帧不会包含在回放中
+	for t := h.oldest(); t <= h.last; t++ {
+		frame, err := h.frame(t)
+		if err != nil {
+			logs.InfoM("回放读取帧失败：", err)
+			continue
+		}
+		replay.Frames = append(replay.Frames, ReplayFrame{T: t, Time: frame.Time, D: frame.D})
+	}
+}
+
+// 是否需要录制回放
+func (r *Room) shouldRecord() bool {
+	return CurrentServer.ReplayDir != "" && (r.option.record || r.master.getApp().option.RecordReplay)
+}
+
+// 异步读取帧数据并写入回放文件
+func (r *Room) saveReplay(replay *Replay, load func()) {
+	go func() {
+		defer runtime.GoRecover()
+		load()
+		id, err := writeReplayFile(CurrentServer.ReplayDir, replay)
+		if err != nil {
+			logs.InfoM("回放写入失败，房间ID:", replay.RoomId, err)
+			return
+		}
+		logs.InfoM("回放已保存：", id, "帧数:", len(replay.Frames))
+	}()
+}
+
+// 写入回放文件，返回回放ID
+func writeReplayFile(dir string, replay *Replay) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	start := strings.ReplaceAll(time.UnixMilli(replay.StartTime).Format("20060102-150405.000"), ".", "-")
+	// 不同应用ID替换不安全字符后可能相同（如a.b与a_b），追加应用ID的校验值区分
+	id := fmt.Sprintf("%s_%08x_%d_%s", replayUnsafe.ReplaceAllString(replay.AppId, "_"), crc32.ChecksumIEEE([]byte(replay.AppId)), replay.RoomId, start)
+	path := filepath.Join(dir, id+replayExt)
+	// 先写入临时文件，完成后再重命名，避免列表中出现不完整的回放
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return "", err
+	}
+	err = WriteReplay(f, replay)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return id, os.Rename(tmp, path)
+}
+
+// 按回放文件格式写入
+func WriteReplay(w io.Writer, replay *Replay) error {
+	if len(replay.AppId) > math.MaxUint16 {
+		return fmt.Errorf("应用ID过长")
+	}
+	header := make([]byte, 8+len(replay.AppId))
+	copy(header, replayMagic)
+	binary.BigEndian.PutUint16(header[4:], ReplayVersion)
+	binary.BigEndian.PutUint16(header[6:], uint16(len(replay.AppId)))
+	copy(header[8:], replay.AppId)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(w)
+	if err := jsoniter.ConfigCompatibleWithStandardLibrary.NewEncoder(gz).Encode(replay); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// 读取回放文件头，返回格式版本和应用ID（版本1的文件头没有应用ID）
+func readReplayHeader(r io.Reader) (int, string, error) {
+	header := make([]byte, 6)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, "", err
+	}
+	if string(header[:4]) != replayMagic {
+		return 0, "", fmt.Errorf("不是有效的回放文件")
+	}
+	v := int(binary.BigEndian.Uint16(header[4:]))
+	if v > ReplayVersion {
+		return 0, "", fmt.Errorf("不支持的回放格式版本：%d", v)
+	}
+	if v < 2 {
+		return v, "", nil
+	}
+	if _, err := io.ReadFull(r, header[:2]); err != nil {
+		return 0, "", err
+	}
+	appid := make([]byte, binary.BigEndian.Uint16(header))
+	if _, err := io.ReadFull(r, appid); err != nil {
+		return 0, "", err
+	}
+	return v, string(appid), nil
+}
+
+// 读取回放文件
+func ReadReplay(r io.Reader) (*Replay, error) {
+	br := bufio.NewReader(r)
+	if _, _, err := readReplayHeader(br); err != nil {
+		return nil, err
+	}
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	replay := &Replay{}
+	if err := jsoniter.ConfigCompatibleWithStandardLibrary.NewDecoder(gz).Decode(replay); err != nil {
+		return nil, err
+	}
+	return replay, nil
+}
+
+// 列出回放文件（按写入时间倒序），appid不为空时只列出该应用的回放
+func ListReplays(dir string, appid string) ([]ReplayInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []ReplayInfo{}, nil
+		}
+		return nil, err
+	}
+	list := []ReplayInfo{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, replayExt) {
+			continue
+		}
+		id := strings.TrimSuffix(name, replayExt)
+		if appid != "" && replayAppId(filepath.Join(dir, name)) != appid {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		list = append(list, ReplayInfo{Id: id, Size: info.Size(), Time: info.ModTime().UnixMilli()})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Time > list[j].Time })
+	return list, nil
+}
+
+// 回放文件的应用ID，读取失败时返回空字符串
+func replayAppId(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	v, appid, err := readReplayHeader(br)
+	if err != nil || v >= 2 {
+		return appid
+	}
+	// 版本1的文件头没有应用ID，需要读取整个回放
+	f.Seek(0, io.SeekStart)
+	replay, err := ReadReplay(f)
+	if err != nil {
+		return ""
+	}
+	return replay.AppId
+}
+
+// 回放文件路径，回放不存在时返回空字符串
+func replayPath(dir string, id string) string {
+	if dir == "" || !replayIdPattern.MatchString(id) {
+		return ""
+	}
+	path := filepath.Join(dir, id+replayExt)
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// 回放列表：GET /hxonline/replays?appid=应用ID
+func listReplays(c *gin.Context) {
+	if CurrentServer.ReplayDir == "" {
+		c.JSON(http.StatusNotFound, gin.H{"msg": "未开启回放录制"})
+		return
+	}
+	list, err := ListReplays(CurrentServer.ReplayDir, c.Query("appid"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"list": list})
+}
+
+// 下载回放：GET /hxonline/replays/:id
+func downloadReplay(c *gin.Context) {
+	path := replayPath(CurrentServer.ReplayDir, c.Param("id"))
+	if path == "" {
+		c.JSON(http.StatusNotFound, gin.H{"msg": "回放不存在"})
+		return
+	}
+	c.FileAttachment(path, filepath.Base(path))
+}
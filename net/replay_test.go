@@ -0,0 +1,160 @@
+package net
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testReplay(appid string) *Replay {
+	return &Replay{
+		Version:    ReplayVersion,
+		AppId:      appid,
+		RoomId:     3,
+		FPS:        30,
+		FrameSync:  FrameSyncOption{Mode: Lockstep, Late: LateShift, IdleFold: defaultIdleFold, Timeout: defaultLockstepTimeout, Straggler: StragglerFill},
+		StartTime:  1000,
+		EndTime:    2000,
+		Master:     1,
+		Users:      []ReplayUser{{Uid: 1, Name: "a", Seat: 1, Data: map[string]any{"level": float64(2)}}},
+		Seats:      map[int]int{1: 1},
+		CustomData: map[string]any{"map": "x"},
+		Frames: []ReplayFrame{
+			{T: 1, Time: 33, D: map[int][]any{1: {"move"}}},
+			{T: 2, Time: 66, D: map[int][]any{}},
+		},
+		Desyncs:     []*DesyncEvent{{T: 2, Uids: []int{1}, Checksums: map[int]string{1: "a"}}},
+		RateChanges: []*FrameRateEvent{{At: 2, Prev: 30, FPS: 60}},
+	}
+}
+
+func TestReplayRoundTrip(t *testing.T) {
+	replay := testReplay("test")
+	buf := &bytes.Buffer{}
+	if err := WriteReplay(buf, replay); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte(replayMagic+"\x00\x02\x00\x04test")) {
+		t.Fatalf("header = %q", buf.Bytes()[:12])
+	}
+	got, err := ReadReplay(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, replay) {
+		t.Fatalf("got %+v, want %+v", got, replay)
+	}
+}
+
+func TestReadReplayInvalid(t *testing.T) {
+	valid := &bytes.Buffer{}
+	WriteReplay(valid, testReplay("test"))
+	newer := append([]byte{}, valid.Bytes()...)
+	binary.BigEndian.PutUint16(newer[4:], ReplayVersion+1)
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"空文件", nil},
+		{"魔数错误", append([]byte("HXRX"), valid.Bytes()[4:]...)},
+		{"更新的版本", newer},
+		{"数据截断", valid.Bytes()[:valid.Len()/2]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadReplay(bytes.NewReader(tt.data)); err == nil {
+				t.Fatal("ReadReplay should fail")
+			}
+		})
+	}
+}
+
+// 版本1的回放文件头没有应用ID
+func writeReplayV1(t *testing.T, path string, replay *Replay) {
+	buf := &bytes.Buffer{}
+	if err := WriteReplay(buf, replay); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	binary.BigEndian.PutUint16(data[4:], 1)
+	data = append(data[:6], data[8+len(replay.AppId):]...)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadReplayV1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "v1"+replayExt)
+	writeReplayV1(t, path, testReplay("old"))
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if replay, err := ReadReplay(f); err != nil || replay.AppId != "old" {
+		t.Fatalf("read v1 replay: %v", err)
+	}
+	if appid := replayAppId(path); appid != "old" {
+		t.Errorf("replayAppId = %q, want old", appid)
+	}
+}
+
+func TestListReplays(t *testing.T) {
+	dir := t.TempDir()
+	ids := []string{}
+	// 应用ID按文件头精确匹配：abc不包含abc_def的回放，a.b与a_b的回放ID不同
+	for _, appid := range []string{"a", "b", "c/../d", "abc", "abc_def", "a.b", "a_b"} {
+		id, err := writeReplayFile(dir, testReplay(appid))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !replayIdPattern.MatchString(id) {
+			t.Fatalf("unsafe replay id %q", id)
+		}
+		ids = append(ids, id)
+		// 保证写入时间不同，列表按写入时间倒序
+		time.Sleep(10 * time.Millisecond)
+	}
+	tests := []struct {
+		appid string
+		want  []string
+	}{
+		{"", []string{ids[6], ids[5], ids[4], ids[3], ids[2], ids[1], ids[0]}},
+		{"a", []string{ids[0]}},
+		{"abc", []string{ids[3]}},
+		{"a.b", []string{ids[5]}},
+		{"a_b", []string{ids[6]}},
+		{"x", nil},
+	}
+	for _, tt := range tests {
+		list, err := ListReplays(dir, tt.appid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, info := range list {
+			got = append(got, info.Id)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ListReplays(%q) = %v, want %v", tt.appid, got, tt.want)
+		}
+	}
+	if ids[5] == ids[6] {
+		t.Errorf("replay ids collide: %q", ids[5])
+	}
+	if replayPath(dir, "../x") != "" {
+		t.Error("replayPath should reject path traversal")
+	}
+	f, err := os.Open(replayPath(dir, ids[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if replay, err := ReadReplay(f); err != nil || replay.AppId != "a" {
+		t.Errorf("read replay: %v", err)
+	}
+}
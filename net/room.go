@@ -5,7 +5,6 @@ import (
 	"sync"
 	"time"
 	"websocket_server/logs"
-	"websocket_server/runtime"
 	"websocket_server/util"
 )
 
@@ -16,23 +15,45 @@ type ClientState struct {
 
 // 房间可选参数
 type RoomConfigOption struct {
-	maxCounts int     // 房间最大容纳人数
-	password  string  // 房间密码，加入房间时，需要验证密码
-	fps       float64 // 帧同步帧率，0 表示使用默认值 30
+	maxCounts int             // 房间最大容纳人数
+	password  string          // 房间密码，加入房间时，需要验证密码
+	fps       float64         // 帧同步帧率，0 表示使用默认值 30
+	frameSync FrameSyncOption // 帧同步模式（自由下发或锁步）
+	record    bool            // 是否录制帧同步回放
+	// 观战参数
+	maxSpectators  int // 观战人数上限，0表示不允许观战
+	spectatorDelay int // 观战用户接收FData的延迟（毫秒），防止通过观战获取实时信息
+	// 玩家操作的限制
+	validator    string // 帧操作校验器（扩展的类型名称），为空时不校验
+	maxInputs    int    // 每个玩家每帧最多提交的操作数
+	maxInputSize int    // 单个操作的最大字节数（按JSON编码后计算）
 }
 
 type Room struct {
 	id            int
 	master        *Client              // 房主
 	users         *util.Array          // 房间用户
+	spectators    *util.Array          // 观战用户（不占用人数与座位，延迟接收FData）
 	roomState     *ClientState         // 房间端的状态栏同步（每个用户都可以共享修改的内容）
 	userStateLock sync.Mutex           // 锁定
 	userState     map[int]*ClientState // 客户端状态数据同步
 	frameSync     bool                 // 是否开启帧同步
 	interval      time.Duration        // 帧同步的间隔
 	lock          bool                 // 房间是否锁定（如果游戏已经开始，则会锁定房间，直到游戏结束，如果用户离线，不会立即退出房间，需要通过`ExitRoom`才能退出房间）
-	frameDatas    *util.Array          // 房间帧数据
+	history       *frameHistory        // 本局帧同步的帧历史
+	frameMu       sync.Mutex           // 保护帧数据的写入与下发，保证追帧与实时FData衔接时不丢帧、不重复
+	syncStart     time.Time            // 本局帧同步的开始时间
+	lockstep      *lockstepState       // 锁步模式的状态，自由下发模式为nil
+	ticker        *tickTask            // 帧调度任务
+	checksums     *checksumState       // 本局帧同步的帧校验记录
+	desyncs       *util.Array          // 本局帧同步检测到的不同步记录
 	cacheId       int                  // 房间已缓存的时间轴Id
+	spectatorTick int                  // 已下发给观战用户的帧（由frameMu保护）
+	spectatorGap  int                  // 最近一次通知观战用户已清除的最后一帧，0表示没有（由frameMu保护）
+	compact       *compactFrames       // 紧凑帧数据的编码状态（由frameMu保护）
+	rateChanges   []*FrameRateEvent    // 本局帧同步已公布的帧率变更（由frameMu保护），最后一项可能尚未生效
+	collected     int                  // 自由下发模式已收集操作的帧（由frameMu保护）
+	inputStats    map[int]*InputStats  // 本局帧同步各玩家的操作到达统计（由frameMu保护）
 	option        *RoomConfigOption    // 房间可选参数
 	matchOption   *MatchOption         // 房间匹配参数
 	customData    *util.Map            // 房间自定义数据
@@ -61,6 +82,16 @@ func (r *Room) updateRoomData(data RoomConfigOption) {
 	if r.users.Length() > data.maxCounts {
 		data.maxCounts = r.users.Length()
 	}
+	if r.spectators.Length() > data.maxSpectators {
+		data.maxSpectators = r.spectators.Length()
+	}
+	// 帧率、帧同步模式与回放录制不在此处修改
+	data.fps = r.option.fps
+	data.frameSync = r.option.frameSync
+	data.record = r.option.record
+	data.validator = r.option.validator
+	data.maxInputs = r.option.maxInputs
+	data.maxInputSize = r.option.maxInputSize
 	r.option = &data
 }
 
@@ -71,7 +102,7 @@ func (r *Room) isInvalidRoom() bool {
 	}
 	hasOnline := false
 	for _, v := range r.users.List {
-		if v.(*Client).Connected {
+		if v.(*Client).isOnline() {
 			hasOnline = true
 		}
 	}
@@ -80,7 +111,7 @@ func (r *Room) isInvalidRoom() bool {
 
 // 将玩家踢出房间
 func (r *Room) kickOut(uid int) {
-	for _, v := range r.users.List {
+	for _, v := range r.members() {
 		c := v.(*Client)
 		if c.uid == uid {
 			r.ExitClient(c)
@@ -94,56 +125,85 @@ func (r *Room) kickOut(uid int) {
 	}
 }
 
-// 房间的帧同步实现
-func onRoomFrame(r *Room) {
-	defer runtime.GoRecover()
-	for {
-		// app := r.master.getApp()
-		if !r.frameSync || r.isInvalidRoom() {
-			// 帧同步停止，或者房间已经不存在用户时
-			logs.InfoM("房间停止帧同步")
-			// 并将所有用户移除
-			// for _, v := range r.users.List {
-			// app.ExitRoom(v.(*Client))
-			// }
-			break
+// 房间的帧同步实现，由帧调度器按帧间隔调用
+func (r *Room) onRoomFrame() tickResult {
+	if !r.frameSync || r.isInvalidRoom() {
+		// 帧同步停止，或者房间已经不存在用户时
+		logs.InfoM("房间停止帧同步")
+		return tickStop
+	}
+	var frameData map[int][]any
+	if l := r.lockstep; l != nil {
+		// 锁步模式：等待所有玩家提交该帧的操作
+		data, ok := r.pollLockstepFrame(l, r.cacheId+1)
+		if !ok {
+			return tickWait
 		}
-		frameData := map[int][]any{}
-		// 收集房间的所有用户操作
-		for _, v := range r.users.List {
-			c := v.(*Client)
-			a := frameData[c.uid]
-			for _, v2 := range c.frames.List {
-				if v2 != nil {
-					f, b := v2.(FrameData)
-					if b {
-						a = append(a, f.Data)
+		frameData = data
+	} else {
+		frameData = r.collectFrames()
+	}
+	r.pushFrame(frameData)
+	return tickDone
+}
+
+// 缓存帧数据并发送到客户端，正在追帧的用户会在追帧分片中收到该帧
+func (r *Room) pushFrame(frameData map[int][]any) {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	if !r.frameSync {
+		// 帧同步已停止（停止时正在执行该帧的回调）
+		return
+	}
+	r.cacheId++
+	r.history.push(frameData, time.Since(r.syncStart).Milliseconds())
+	r.applyRateChange(r.cacheId)
+	// 同一帧只编码一次
+	p := NewPreparedMessage(&ClientMessage{
+		Op: FData,
+		Data: map[string]any{
+			"t": r.cacheId,
+			"d": frameData,
+		},
+	})
+	for _, v := range r.users.List {
+		c := v.(*Client)
+		if !c.catchingUp && !c.compact {
+			c.SendPrepared(p)
+		}
+	}
+	r.sendCompactFrame(r.compact.next(r.cacheId, frameData))
+	r.pushSpectatorFrames(false)
+}
+
+// 收集房间所有用户在该帧生效的操作，期望在之后的帧生效的操作继续保留
+func (r *Room) collectFrames() map[int][]any {
+	r.frameMu.Lock()
+	defer r.frameMu.Unlock()
+	r.collected++
+	inputs := map[int][]FrameData{}
+	for _, v := range r.users.List {
+		c := v.(*Client)
+		a := inputs[c.uid]
+		keep := []any{}
+		for _, v2 := range c.frames.List {
+			if v2 != nil {
+				f, b := v2.(FrameData)
+				if b {
+					if f.Tick > r.collected {
+						keep = append(keep, f)
+						continue
 					}
+					a = append(a, f)
 				}
 			}
-			if a != nil {
-				frameData[c.uid] = a
-			}
-			c.frames.List = []any{}
 		}
-
-		// 缓存数据
-		r.cacheId++
-		r.frameDatas.Push(frameData)
-		// 发送帧数据到客户端（frameData 直接传入，由 SendToUserOp 内部 Marshal）
-		for _, v := range r.users.List {
-			c := v.(*Client)
-			c.SendToUserOp(&ClientMessage{
-				Op: FData,
-				Data: map[string]any{
-					"t": r.cacheId,
-					"d": frameData,
-				},
-			})
+		if a != nil {
+			inputs[c.uid] = a
 		}
-		// 帧同步发送间隔
-		time.Sleep(r.interval)
+		c.frames.List = keep
 	}
+	return r.frameInputs(inputs)
 }
 
 // 记录服务器的房间信息
@@ -155,30 +215,76 @@ func (r *Room) recordRoomMessage(data *ClientMessage) {
 	}
 }
 
-// 启动帧同步
-func (r *Room) StartFrameSync() {
+// 启动帧同步，option可指定帧同步模式（nil时使用房间配置）
+func (r *Room) StartFrameSync(option *FrameSyncOption) {
 	if r.frameSync {
 		return
 	}
-	logs.InfoM("StartFrameSync")
+	r.option.frameSync.merge(option)
+	mode := r.option.frameSync.normalize()
+	logs.InfoM("StartFrameSync", mode.Mode)
+	r.lockstep = nil
+	r.checksums = newChecksumState()
+	r.desyncs = util.CreateArray()
+	r.resetFrameHistory()
+	r.frameMu.Lock()
+	r.syncStart = time.Now()
+	r.spectatorTick = 0
+	r.spectatorGap = 0
+	r.compact = newCompactFrames(mode.IdleFold)
+	r.rateChanges = nil
+	r.collected = r.cacheId
+	r.inputStats = map[int]*InputStats{}
+	r.frameMu.Unlock()
+	if mode.Mode == Lockstep {
+		r.lockstep = newLockstepState(mode, r.cacheId)
+	}
+	for _, v := range r.members() {
+		// 帧同步的FData会与回放冲突，停止房间用户正在播放的回放
+		v.(*Client).stopPlayback()
+	}
 	r.frameSync = true
 	r.lock = true
 	// 所有人都要接收这个字节，确保帧同步启动
 	r.SendToAllUserOp(&ClientMessage{
-		Op: FrameSyncReady,
+		Op:   FrameSyncReady,
+		Data: mode,
 	}, nil)
-	go onRoomFrame(r)
+	// 帧同步重新启动时替换之前的调度任务
+	frameScheduler.stop(r.ticker)
+	r.ticker = frameScheduler.add(r)
 	// 通知大厅房间列表变更
 	r.master.getApp().broadcastRoomListChanged()
 }
 
 // 停止帧同步
 func (r *Room) StopFrameSync(keepLock bool) {
-	r.frameSync = false
+	// 先停止调度，执行中的帧回调在帧锁内发现帧同步已停止后不再下发
+	frameScheduler.stop(r.ticker)
+	r.ticker = nil
+	var replay *Replay
+	var loadReplay func()
+	r.frameMu.Lock()
+	if r.frameSync {
+		r.frameSync = false
+		// 对局结束后不再需要延迟，补发观战用户尚未收到的帧，并下发紧凑模式中尚未下发的空帧
+		r.sendCompactFrame(r.compact.flush(r.cacheId))
+		r.pushSpectatorFrames(true)
+		// 录制本局帧同步的回放（停止前至少产生过一帧）
+		if r.cacheId > 0 && r.shouldRecord() {
+			replay, loadReplay = r.buildReplay()
+		}
+	}
+	r.cacheId = 0
+	r.spectatorTick = 0
+	r.spectatorGap = 0
+	r.frameMu.Unlock()
+	if replay != nil {
+		r.saveReplay(replay, loadReplay)
+	}
 	if !keepLock {
 		r.lock = false
 	}
-	r.cacheId = 0
 	// 清理房间的僵尸玩家（离线但未退出房间的玩家）
 	r.cleanZombieClients()
 	// 通知大厅房间列表变更
@@ -191,7 +297,7 @@ func (r *Room) cleanZombieClients() {
 	var zombies []*Client
 	for _, v := range r.users.List {
 		c := v.(*Client)
-		if !c.Connected {
+		if !c.isOnline() {
 			zombies = append(zombies, c)
 		}
 	}
@@ -250,16 +356,17 @@ func (r *Room) SwitchSeat(client *Client, targetSeat int) error {
 
 // 给房间的所有用户发送消息
 func (r *Room) SendToAllUser(data []byte) {
-	for _, v := range r.users.List {
+	for _, v := range r.members() {
 		v.(*Client).SendToUser(data)
 	}
 }
 
 // 给房间的所有用户发送消息
 func (r *Room) SendToAllUserOp(data *ClientMessage, igoneClient *Client) {
-	for _, v := range r.users.List {
+	p := NewPreparedMessage(data)
+	for _, v := range r.members() {
 		if v != igoneClient {
-			v.(*Client).SendToUserOp(data)
+			v.(*Client).SendPrepared(p)
 		}
 	}
 }
@@ -298,6 +405,10 @@ func (r *Room) onRoomChanged() {
 
 // 用户退出
 func (r *Room) ExitClient(client *Client) {
+	if client.spectator {
+		r.exitSpectator(client)
+		return
+	}
 	if client.room != nil && r.users != nil && r.users.List != nil {
 		if client.room.id == r.id {
 			// 记录退出前的座位号，用于判断是否需要取消角色选择
@@ -370,6 +481,20 @@ func (r *Room) GetRoomData() any {
 	data["users"] = users.List
 	data["seats"] = seats
 	data["max"] = r.option.maxCounts
+	spectators := util.Array{}
+	for _, v := range r.spectators.List {
+		spectators.Push(v.(*Client).GetUserData())
+	}
+	data["spectators"] = spectators.List
+	data["maxSpectators"] = r.option.maxSpectators
+	data["fps"] = r.fps()
+	r.frameMu.Lock()
+	data["rateChanges"] = append([]*FrameRateEvent{}, r.rateChanges...)
+	data["inputStats"] = r.copyInputStats()
+	r.frameMu.Unlock()
+	data["validator"] = r.option.validator
+	data["maxInputs"] = r.option.maxInputs
+	data["maxInputSize"] = r.option.maxInputSize
 	data["data"] = r.customData.Copy()
 	data["state"] = r.roomState.Data.Copy()
 	var state map[int]any = map[int]any{}
@@ -0,0 +1,275 @@
+package net
+
+import (
+	"container/heap"
+	"net/http"
+	stdruntime "runtime"
+	"sync"
+	"time"
+	"websocket_server/logs"
+	"websocket_server/runtime"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 帧回调的执行结果
+type tickResult int
+
+const (
+	tickDone tickResult = iota // 已下发一帧，按帧间隔安排下一次
+	tickWait                   // 等待中（如锁步等待玩家操作），可被唤醒立即重试
+	tickStop                   // 停止调度
+)
+
+// 落后超过该帧数时跳过落后的帧（只补发不超过该数量的帧），避免阻塞后集中下发
+const maxTickCatchUp = 3
+
+// 帧调度器：所有帧同步房间共用一个调度协程，按绝对时间（上一次的计划时间 + 帧间隔）触发，
+// 不会因为每帧的处理耗时而累积延迟；到期的任务交给工作协程执行
+type tickScheduler struct {
+	mu      sync.Mutex
+	tasks   tickHeap       // 按下次触发时间排序的任务
+	wake    chan struct{}  // 有更早的任务加入时唤醒调度协程
+	ready   chan *tickTask // 到期等待执行的任务
+	all     map[*tickTask]bool
+	started sync.Once
+}
+
+// 房间的帧调度任务
+type tickTask struct {
+	room     *Room
+	interval time.Duration // 帧间隔，每次执行后从房间同步（帧率可能在帧同步中变更）
+	next     time.Time     // 下次触发的计划时间
+	index    int           // 在堆中的位置，-1表示不在堆中（执行中或已停止）
+	waiting  bool          // 上一次执行的结果为等待
+	stopped  bool
+	woken    bool // 本次为唤醒触发（不计入抖动统计）
+	pending  bool // 执行期间收到唤醒，执行结果为等待时立即重试
+	stats    TickStats
+	jitterMs float64 // 累计抖动，用于计算平均值
+}
+
+// 房间的帧调度统计
+type TickStats struct {
+	AppId     string  `json:"appid"`     // 应用ID
+	RoomId    int     `json:"roomId"`    // 房间ID
+	FPS       float64 `json:"fps"`       // 帧率
+	Ticks     int     `json:"ticks"`     // 已触发次数
+	Skipped   int     `json:"skipped"`   // 落后过多被跳过的帧数
+	Jitter    float64 `json:"jitter"`    // 最近一次触发相对计划时间的延迟（毫秒）
+	AvgJitter float64 `json:"avgJitter"` // 平均延迟（毫秒）
+	MaxJitter float64 `json:"maxJitter"` // 最大延迟（毫秒）
+}
+
+var frameScheduler = &tickScheduler{
+	wake:  make(chan struct{}, 1),
+	ready: make(chan *tickTask, 1024),
+	all:   map[*tickTask]bool{},
+}
+
+// 添加房间的帧调度，第一帧在一个帧间隔后触发
+func (s *tickScheduler) add(r *Room) *tickTask {
+	s.started.Do(s.start)
+	interval := r.frameInterval()
+	t := &tickTask{room: r, interval: interval, next: time.Now().Add(interval), index: -1}
+	t.stats.RoomId = r.id
+	t.stats.AppId = r.master.appid
+	s.mu.Lock()
+	s.all[t] = true
+	s.schedule(t)
+	s.mu.Unlock()
+	return t
+}
+
+// 停止任务，执行中的任务会在本次执行结束后移除
+func (s *tickScheduler) stop(t *tickTask) {
+	if t == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t.stopped = true
+	delete(s.all, t)
+	if t.index >= 0 {
+		heap.Remove(&s.tasks, t.index)
+	}
+}
+
+// 唤醒等待中的任务立即执行（如锁步模式收到玩家操作）
+func (s *tickScheduler) wakeUp(t *tickTask) {
+	if t == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.index < 0 {
+		t.pending = !t.stopped
+	} else if t.waiting {
+		t.next = time.Now()
+		t.woken = true
+		heap.Fix(&s.tasks, t.index)
+		s.notify()
+	}
+}
+
+// 加入堆，需要在锁内调用
+func (s *tickScheduler) schedule(t *tickTask) {
+	heap.Push(&s.tasks, t)
+	if t.index == 0 {
+		s.notify()
+	}
+}
+
+func (s *tickScheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *tickScheduler) start() {
+	go s.dispatch()
+	for i := 0; i < stdruntime.NumCPU(); i++ {
+		go s.work()
+	}
+}
+
+// 调度协程：等待最早的任务到期，并交给工作协程执行
+func (s *tickScheduler) dispatch() {
+	defer runtime.GoRecover()
+	timer := time.NewTimer(time.Hour)
+	for {
+		s.mu.Lock()
+		var due []*tickTask
+		wait := time.Hour
+		now := time.Now()
+		for s.tasks.Len() > 0 {
+			t := s.tasks[0]
+			if d := t.next.Sub(now); d > 0 {
+				wait = d
+				break
+			}
+			heap.Pop(&s.tasks)
+			due = append(due, t)
+		}
+		s.mu.Unlock()
+		for _, t := range due {
+			s.ready <- t
+		}
+		timer.Reset(wait)
+		select {
+		case <-timer.C:
+		case <-s.wake:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		}
+	}
+}
+
+// 工作协程：执行房间的帧回调，并按结果安排下一次
+func (s *tickScheduler) work() {
+	for t := range s.ready {
+		s.run(t)
+	}
+}
+
+func (s *tickScheduler) run(t *tickTask) {
+	defer runtime.GoRecover()
+	start := time.Now()
+	s.mu.Lock()
+	if t.stopped {
+		s.mu.Unlock()
+		return
+	}
+	if !t.woken {
+		t.record(start.Sub(t.next))
+	}
+	t.woken = false
+	s.mu.Unlock()
+
+	result := t.room.onRoomFrame()
+	// 帧间隔由帧锁保护，在获取调度器锁之前读取
+	interval := t.room.frameInterval()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.stopped {
+		return
+	}
+	if result == tickStop {
+		t.stopped = true
+		delete(s.all, t)
+		return
+	}
+	t.interval = interval
+	t.waiting = result == tickWait
+	t.next = t.next.Add(interval)
+	// 落后过多时跳过落后的帧，只保留maxTickCatchUp帧的补发
+	if behind := int(time.Since(t.next) / interval); behind > maxTickCatchUp {
+		skipped := behind - maxTickCatchUp
+		t.next = t.next.Add(time.Duration(skipped) * interval)
+		t.stats.Skipped += skipped
+		logs.InfoM("帧调度落后，跳过", skipped, "帧，房间ID:", t.room.id)
+	}
+	if t.waiting && t.pending {
+		t.next = time.Now()
+		t.woken = true
+	}
+	t.pending = false
+	s.schedule(t)
+}
+
+// 记录触发的延迟，需要在锁内调用
+func (t *tickTask) record(late time.Duration) {
+	ms := float64(late) / float64(time.Millisecond)
+	t.stats.Ticks++
+	t.stats.Jitter = ms
+	if ms > t.stats.MaxJitter {
+		t.stats.MaxJitter = ms
+	}
+	t.jitterMs += ms
+	t.stats.AvgJitter = t.jitterMs / float64(t.stats.Ticks)
+}
+
+// 所有正在调度的房间的统计
+func (s *tickScheduler) list() []TickStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]TickStats, 0, len(s.all))
+	for t := range s.all {
+		stats := t.stats
+		stats.FPS = float64(time.Second) / float64(t.interval)
+		list = append(list, stats)
+	}
+	return list
+}
+
+// 按下次触发时间排序的最小堆
+type tickHeap []*tickTask
+
+func (h tickHeap) Len() int           { return len(h) }
+func (h tickHeap) Less(i, j int) bool { return h[i].next.Before(h[j].next) }
+func (h tickHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *tickHeap) Push(x any) {
+	t := x.(*tickTask)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+func (h *tickHeap) Pop() any {
+	old := *h
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	t.index = -1
+	*h = old[:len(old)-1]
+	return t
+}
+
+// 帧调度统计：GET /hxonline/ticks
+func listTickStats(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"list": frameScheduler.list()})
+}
@@ -0,0 +1,139 @@
+package net
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+)
+
+func newTestScheduler() *tickScheduler {
+	return &tickScheduler{
+		wake:  make(chan struct{}, 1),
+		ready: make(chan *tickTask, 16),
+		all:   map[*tickTask]bool{},
+	}
+}
+
+// 加入一个指定触发时间的任务（不启动调度协程）
+func (s *tickScheduler) addTestTask(r *Room, next time.Time) *tickTask {
+	t := &tickTask{room: r, interval: r.interval, next: next, index: -1}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.all[t] = true
+	s.schedule(t)
+	return t
+}
+
+// 锁步等待中的房间，每次执行的结果都为等待
+func newWaitingTestRoom(interval time.Duration) *Room {
+	r := newLockstepTestRoom(1)
+	r.frameSync = true
+	r.interval = interval
+	r.lockstep = newLockstepState(FrameSyncOption{Mode: Lockstep}, 0)
+	r.lockstep.timeout = time.Hour
+	return r
+}
+
+func TestTickHeapOrder(t *testing.T) {
+	s := newTestScheduler()
+	now := time.Now()
+	r := newWaitingTestRoom(time.Second)
+	c := s.addTestTask(r, now.Add(3*time.Millisecond))
+	a := s.addTestTask(r, now.Add(time.Millisecond))
+	b := s.addTestTask(r, now.Add(2*time.Millisecond))
+	s.stop(b)
+	if b.index != -1 || len(s.all) != 2 {
+		t.Fatalf("stopped task still scheduled: index=%d all=%d", b.index, len(s.all))
+	}
+	for _, want := range []*tickTask{a, c} {
+		if got := s.tasks[0]; got != want {
+			t.Fatalf("first task = %v, want %v", got.next, want.next)
+		}
+		s.mu.Lock()
+		heap.Pop(&s.tasks)
+		s.mu.Unlock()
+	}
+}
+
+func TestTickRun(t *testing.T) {
+	const interval = 10 * time.Millisecond
+	tests := []struct {
+		name    string
+		late    time.Duration // 本次触发相对计划时间的延迟
+		pending bool          // 执行期间收到唤醒
+		skipped int
+		rerun   bool // 立即重试
+	}{
+		{"按时触发", 0, false, 0, false},
+		{"落后在补发范围内", 3 * interval, false, 0, false},
+		{"落后过多", 10*interval + interval/2, false, 6, false},
+		{"执行期间被唤醒", 0, true, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestScheduler()
+			planned := time.Now().Add(-tt.late)
+			task := s.addTestTask(newWaitingTestRoom(interval), planned)
+			s.mu.Lock()
+			heap.Pop(&s.tasks)
+			task.pending = tt.pending
+			s.mu.Unlock()
+			s.run(task)
+			if task.index < 0 || !task.waiting || task.pending {
+				t.Fatalf("index=%d waiting=%v pending=%v", task.index, task.waiting, task.pending)
+			}
+			if task.stats.Ticks != 1 || task.stats.Skipped != tt.skipped {
+				t.Errorf("ticks=%d skipped=%d, want 1 and %d", task.stats.Ticks, task.stats.Skipped, tt.skipped)
+			}
+			if task.woken != tt.rerun {
+				t.Errorf("woken = %v, want %v", task.woken, tt.rerun)
+			}
+			if !tt.rerun {
+				want := planned.Add(time.Duration(1+tt.skipped) * interval)
+				if !task.next.Equal(want) {
+					t.Errorf("next = +%v, want +%v", task.next.Sub(planned), want.Sub(planned))
+				}
+			}
+		})
+	}
+}
+
+// 等待中的任务被唤醒时立即触发，唤醒触发不计入抖动统计
+func TestTickWakeUp(t *testing.T) {
+	s := newTestScheduler()
+	task := s.addTestTask(newWaitingTestRoom(time.Second), time.Now().Add(time.Hour))
+	s.wakeUp(task)
+	if task.woken {
+		t.Fatal("task not waiting should not be woken")
+	}
+	task.waiting = true
+	s.wakeUp(task)
+	if !task.woken || time.Until(task.next) > 0 {
+		t.Fatalf("woken=%v next in %v", task.woken, time.Until(task.next))
+	}
+	s.mu.Lock()
+	heap.Pop(&s.tasks)
+	s.mu.Unlock()
+	s.run(task)
+	if task.stats.Ticks != 0 || task.woken {
+		t.Errorf("ticks=%d woken=%v after woken run", task.stats.Ticks, task.woken)
+	}
+}
+
+// 帧同步停止时任务被移除
+func TestTickRunStop(t *testing.T) {
+	s := newTestScheduler()
+	r := newWaitingTestRoom(time.Second)
+	r.frameSync = false
+	task := s.addTestTask(r, time.Now())
+	s.mu.Lock()
+	heap.Pop(&s.tasks)
+	s.mu.Unlock()
+	s.run(task)
+	if !task.stopped || task.index >= 0 || len(s.all) != 0 {
+		t.Fatalf("stopped=%v index=%d all=%d", task.stopped, task.index, len(s.all))
+	}
+	if list := s.list(); len(list) != 0 {
+		t.Errorf("list = %v", list)
+	}
+}
@@ -0,0 +1,347 @@
+package net
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 协议描述：op、错误码以及各op的data结构，用于生成JSON Schema、TypeScript与Haxe类型定义。
+// op与错误码的名称、值和注释直接从源码中解析，data结构通过反射获取，保证与Go定义一致。
+// 源码不会打包进程序，只能在源码目录中生成（go generate、go test）
+
+// op的data结构，nil表示没有data
+type opPayload struct {
+	Request reflect.Type // 客户端请求的data
+	Reply   reflect.Type // 服务器直接回复的data
+	Event   reflect.Type // 服务器推送事件的data
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+var (
+	anyType = typeOf[any]()
+	objType = typeOf[map[string]any]()
+)
+
+// 各op的data结构，未声明的op没有data
+var opPayloads = map[ClientAction]opPayload{
+	Error:                 {Event: typeOf[ClientError]()},
+	Message:               {Request: anyType},
+	CreateRoom:            {Request: typeOf[CreateRoomRequest](), Reply: typeOf[RoomIdReply]()},
+	JoinRoom:              {Request: typeOf[JoinRoomRequest](), Reply: typeOf[RoomIdReply]()},
+	GetRoomData:           {Reply: typeOf[RoomData](), Event: typeOf[RoomData]()},
+	StartFrameSync:        {Request: typeOf[FrameSyncOption]()},
+	UploadFrame:           {Request: anyType, Reply: typeOf[UploadFrameReply]()},
+	Login:                 {Request: typeOf[LoginRequest](), Reply: typeOf[LoginReply]()},
+	FData:                 {Event: typeOf[FrameEvent]()},
+	FrameSyncReady:        {Event: typeOf[FrameSyncOption]()},
+	RoomMessage:           {Request: anyType, Reply: anyType, Event: typeOf[UserPayload]()},
+	JoinRoomClient:        {Event: typeOf[UserData]()},
+	ExitRoomClient:        {Event: typeOf[UserData]()},
+	OutOnlineRoomClient:   {Event: typeOf[UserData]()},
+	MatchUser:             {Request: typeOf[MatchOption]()},
+	UpdateUserData:        {Request: objType},
+	GetRoomOldMessage:     {Reply: typeOf[RoomOldMessageReply]()},
+	UpdateRoomCustomData:  {Request: objType},
+	UpdateRoomOption:      {Request: typeOf[UpdateRoomOptionRequest]()},
+	KickOut:               {Request: typeOf[UidRequest]()},
+	GetFrameAt:            {Request: typeOf[GetFrameAtRequest](), Reply: typeOf[[]map[int][]any]()},
+	SetRoomState:          {Request: objType},
+	RoomStateUpdate:       {Event: objType},
+	SetClientState:        {Request: objType},
+	ClientStateUpdate:     {Event: typeOf[UserPayload]()},
+	MatchRoom:             {Request: typeOf[MatchOption](), Reply: typeOf[RoomIdReply]()},
+	SetRoomMatchOption:    {Request: typeOf[MatchOption]()},
+	UpdateRoomUserData:    {Event: typeOf[UserPayload]()},
+	GetRoomList:           {Request: typeOf[GetRoomListRequest](), Reply: typeOf[RoomListReply]()},
+	SendServerMsg:         {Request: anyType},
+	EVENT_GetServerMsg:    {Event: typeOf[UserPayload]()},
+	ListenerServer:        {Request: typeOf[ListenerRequest]()},
+	CannelListenerServer:  {Request: typeOf[ListenerRequest]()},
+	GetUserDataByUID:      {Request: typeOf[UidRequest](), Reply: typeOf[UserDataByUidReply]()},
+	GetServerOldMsg:       {Request: typeOf[GetServerOldMsgRequest]()},
+	ExtendsCall:           {Request: typeOf[ExtendsCallRequest](), Reply: anyType},
+	SendToUser:            {Request: typeOf[SendToUserRequest]()},
+	UserMessage:           {Event: typeOf[UserPayload]()},
+	QueryRoomList:         {Request: typeOf[QueryRoomListRequest](), Reply: typeOf[QueryRoomListReply]()},
+	SwitchSeat:            {Request: typeOf[SwitchSeatRequest]()},
+	SeatUpdate:            {Event: typeOf[SeatUpdateEvent]()},
+	EVENT_RoomListChanged: {Event: typeOf[RoomListChangedEvent]()},
+	ResumeSession:         {Request: typeOf[ResumeSessionRequest](), Reply: typeOf[LoginReply]()},
+	Batch:                 {Event: typeOf[[]any]()},
+	MessagesDropped:       {Event: typeOf[MessagesDroppedEvent]()},
+	ReportChecksum:        {Request: typeOf[ReportChecksumRequest]()},
+	Desync:                {Event: typeOf[DesyncEvent]()},
+	FrameCatchUp:          {Request: typeOf[FrameCatchUpRequest](), Reply: typeOf[CatchUpInfo](), Event: typeOf[CatchUpInfo]()},
+	CatchUpChunk:          {Event: typeOf[CatchUpChunkEvent]()},
+	PlayReplay:            {Request: typeOf[PlayReplayRequest](), Reply: typeOf[ReplayMeta]()},
+	ReplayControl:         {Request: typeOf[ReplayControlRequest](), Reply: typeOf[ReplayStateEvent]()},
+	ReplayState:           {Event: typeOf[ReplayStateEvent]()},
+	TimeSync:              {Request: typeOf[TimeSyncRequest](), Reply: typeOf[TimeSyncReply]()},
+	Ping:                  {Event: typeOf[PingEvent]()},
+	Pong:                  {Request: typeOf[PongRequest]()},
+	ChangeFrameRate:       {Request: typeOf[ChangeFrameRateRequest](), Reply: typeOf[FrameRateEvent]()},
+	FrameRateChanged:      {Event: typeOf[FrameRateEvent]()},
+}
+
+// 协议描述
+type Schema struct {
+	Version int            // 协议版本
+	Ops     []*OpSchema    // 所有op（按值排序）
+	Errors  []*ConstSchema // 所有错误码（按值排序）
+	Types   []*TypeSchema  // data中用到的结构（按首次使用顺序）
+	types   map[reflect.Type]*TypeSchema
+	docs    map[string]string // 源码注释：常量名或`类型名.字段名` -> 注释
+}
+
+// op描述
+type OpSchema struct {
+	ConstSchema
+	Since   int      // 客户端可调用该op的最低协议版本，0表示仅由服务器下发
+	Request *TypeRef // 请求的data，nil表示没有data
+	Reply   *TypeRef // 直接回复的data
+	Event   *TypeRef // 推送事件的data
+}
+
+// 常量描述
+type ConstSchema struct {
+	Name  string
+	Value int
+	Doc   string
+}
+
+// 结构描述
+type TypeSchema struct {
+	Name   string
+	Doc    string
+	Fields []*FieldSchema
+}
+
+// 字段描述
+type FieldSchema struct {
+	Name     string // JSON字段名
+	Doc      string
+	Type     *TypeRef
+	Optional bool   // 是否可省略（omitempty）
+	Rules    string // 校验规则（validate标签）
+}
+
+// 类型引用
+type TypeRef struct {
+	Kind string   // integer、number、string、boolean、any、array、map、object
+	Elem *TypeRef // array与map的元素类型
+	Key  string   // map的key类型：integer、string
+	Ref  string   // object引用的结构名称
+}
+
+// 生成协议描述
+func BuildSchema() (*Schema, error) {
+	s := &Schema{
+		Version: ProtocolVersion,
+		types:   map[reflect.Type]*TypeSchema{},
+		docs:    map[string]string{},
+	}
+	ops, errors, err := s.parseSources()
+	if err != nil {
+		return nil, err
+	}
+	s.Errors = errors
+	for _, c := range ops {
+		op := &OpSchema{ConstSchema: *c, Since: opVersions[ClientAction(c.Value)]}
+		p := opPayloads[ClientAction(c.Value)]
+		op.Request = s.typeRef(p.Request)
+		op.Reply = s.typeRef(p.Reply)
+		op.Event = s.typeRef(p.Event)
+		s.Ops = append(s.Ops, op)
+	}
+	s.typeRef(typeOf[ClientMessage]())
+	return s, nil
+}
+
+// 解析源码中的ClientAction、ClientErrorCode常量以及结构字段的注释
+func (s *Schema) parseSources() ([]*ConstSchema, []*ConstSchema, error) {
+	dir, err := sourceDir()
+	if err != nil {
+		return nil, nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	ops := []*ConstSchema{}
+	errors := []*ConstSchema{}
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.ValueSpec:
+					ident, ok := spec.Type.(*ast.Ident)
+					if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+						continue
+					}
+					value, err := constValue(spec.Values[0])
+					if err != nil {
+						continue
+					}
+					c := &ConstSchema{Name: spec.Names[0].Name, Value: value, Doc: commentText(spec.Comment)}
+					switch ident.Name {
+					case "ClientAction":
+						ops = append(ops, c)
+					case "ClientErrorCode":
+						errors = append(errors, c)
+					}
+				case *ast.TypeSpec:
+					st, ok := spec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					doc := commentText(spec.Doc)
+					if doc == "" && len(gen.Specs) == 1 {
+						doc = commentText(gen.Doc)
+					}
+					s.docs[spec.Name.Name] = doc
+					for _, field := range st.Fields.List {
+						for _, name := range field.Names {
+							s.docs[spec.Name.Name+"."+name.Name] = commentText(field.Comment)
+						}
+					}
+				}
+			}
+		}
+	}
+	sort.SliceStable(ops, func(i, j int) bool { return ops[i].Value < ops[j].Value })
+	sort.SliceStable(errors, func(i, j int) bool { return errors[i].Value < errors[j].Value })
+	return ops, errors, nil
+}
+
+// net包的源码目录（编译时的路径）
+func sourceDir() (string, error) {
+	_, file, _, ok := runtime.Caller(0)
+	if ok {
+		dir := filepath.Dir(file)
+		if _, err := os.Stat(filepath.Join(dir, "protocol.go")); err == nil {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("找不到net包的源码，请在项目根目录执行go generate生成协议描述")
+}
+
+// 常量的整数值（支持负数）
+func constValue(expr ast.Expr) (int, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return strconv.Atoi(e.Value)
+	case *ast.UnaryExpr:
+		if e.Op == token.SUB {
+			v, err := constValue(e.X)
+			return -v, err
+		}
+	}
+	return 0, fmt.Errorf("unsupported const value")
+}
+
+func commentText(group *ast.CommentGroup) string {
+	if group == nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.ReplaceAll(group.Text(), "\n", " "))
+}
+
+// 获取类型引用，结构类型会加入到Types中
+func (s *Schema) typeRef(t reflect.Type) *TypeRef {
+	if t == nil {
+		return nil
+	}
+	if t == typeOf[ClientAction]() || t == typeOf[ClientErrorCode]() {
+		return &TypeRef{Kind: "integer"}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return s.typeRef(t.Elem())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &TypeRef{Kind: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &TypeRef{Kind: "number"}
+	case reflect.String:
+		return &TypeRef{Kind: "string"}
+	case reflect.Bool:
+		return &TypeRef{Kind: "boolean"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// 二进制数据在JSON中编码为base64字符串
+			return &TypeRef{Kind: "string"}
+		}
+		return &TypeRef{Kind: "array", Elem: s.typeRef(t.Elem())}
+	case reflect.Map:
+		key := "string"
+		if t.Key().Kind() != reflect.String {
+			key = "integer"
+		}
+		return &TypeRef{Kind: "map", Key: key, Elem: s.typeRef(t.Elem())}
+	case reflect.Struct:
+		s.structType(t)
+		return &TypeRef{Kind: "object", Ref: t.Name()}
+	}
+	return &TypeRef{Kind: "any"}
+}
+
+// 生成结构描述
+func (s *Schema) structType(t reflect.Type) {
+	if _, ok := s.types[t]; ok {
+		return
+	}
+	ts := &TypeSchema{Name: t.Name(), Doc: s.docs[t.Name()]}
+	s.types[t] = ts
+	s.Types = append(s.Types, ts)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		optional := false
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		if tag[0] == "-" {
+			continue
+		}
+		if tag[0] != "" {
+			name = tag[0]
+		}
+		for _, opt := range tag[1:] {
+			if opt == "omitempty" {
+				optional = true
+			}
+		}
+		ts.Fields = append(ts.Fields, &FieldSchema{
+			Name:     name,
+			Doc:      s.docs[t.Name()+"."+f.Name],
+			Type:     s.typeRef(f.Type),
+			Optional: optional,
+			Rules:    f.Tag.Get("validate"),
+		})
+	}
+}
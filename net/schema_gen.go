@@ -0,0 +1,292 @@
+package net
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 生成指定格式的协议描述：json（JSON Schema）、ts（TypeScript）、haxe
+func GenerateSchema(format string) ([]byte, error) {
+	s, err := BuildSchema()
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case "", "json":
+		return s.JSONSchema()
+	case "ts":
+		return []byte(s.TypeScript()), nil
+	case "haxe":
+		return []byte(s.Haxe()), nil
+	}
+	return nil, fmt.Errorf("未知的格式：%s，可选json、ts、haxe", format)
+}
+
+// ===== JSON Schema =====
+
+// 生成JSON Schema（draft 2020-12），结构定义在$defs中，ops与errors描述各op的data结构与错误码
+func (s *Schema) JSONSchema() ([]byte, error) {
+	defs := map[string]any{}
+	for _, t := range s.Types {
+		props := map[string]any{}
+		required := []string{}
+		for _, f := range t.Fields {
+			props[f.Name] = withDoc(withRules(f.Type.jsonSchema(), f.Type, f.Rules), f.Doc)
+			if !f.Optional {
+				required = append(required, f.Name)
+			}
+		}
+		defs[t.Name] = withDoc(map[string]any{
+			"type":       "object",
+			"properties": props,
+			"required":   required,
+		}, t.Doc)
+	}
+	ops := []any{}
+	for _, op := range s.Ops {
+		o := map[string]any{
+			"name":  op.Name,
+			"op":    op.Value,
+			"doc":   op.Doc,
+			"since": op.Since,
+		}
+		if op.Request != nil {
+			o["request"] = op.Request.jsonSchema()
+		}
+		if op.Reply != nil {
+			o["reply"] = op.Reply.jsonSchema()
+		}
+		if op.Event != nil {
+			o["event"] = op.Event.jsonSchema()
+		}
+		ops = append(ops, o)
+	}
+	errors := []any{}
+	for _, e := range s.Errors {
+		errors = append(errors, map[string]any{
+			"name": e.Name,
+			"code": e.Value,
+			"doc":  e.Doc,
+		})
+	}
+	return jsoniter.ConfigCompatibleWithStandardLibrary.MarshalIndent(map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "hxonline",
+		"version": s.Version,
+		"$ref":    "#/$defs/ClientMessage",
+		"$defs":   defs,
+		"ops":     ops,
+		"errors":  errors,
+	}, "", "  ")
+}
+
+func withDoc(schema map[string]any, doc string) map[string]any {
+	if doc != "" {
+		schema["description"] = doc
+	}
+	return schema
+}
+
+// 将校验规则转换为JSON Schema的约束
+func withRules(schema map[string]any, t *TypeRef, rules string) map[string]any {
+	if rules == "" {
+		return schema
+	}
+	keys := map[string][2]string{
+		"integer": {"minimum", "maximum"},
+		"number":  {"minimum", "maximum"},
+		"string":  {"minLength", "maxLength"},
+		"array":   {"minItems", "maxItems"},
+		"map":     {"minProperties", "maxProperties"},
+	}
+	key, ok := keys[t.Kind]
+	if !ok {
+		return schema
+	}
+	for _, rule := range strings.Split(rules, ",") {
+		name, arg, _ := strings.Cut(rule, "=")
+		v, _ := strconv.ParseFloat(arg, 64)
+		switch name {
+		case "oneof":
+			schema["enum"] = strings.Fields(arg)
+		case "min":
+			schema[key[0]] = v
+		case "max":
+			schema[key[1]] = v
+		case "nonempty":
+			schema[key[0]] = 1
+		}
+	}
+	return schema
+}
+
+func (t *TypeRef) jsonSchema() map[string]any {
+	switch t.Kind {
+	case "any":
+		return map[string]any{}
+	case "array":
+		return map[string]any{"type": "array", "items": t.Elem.jsonSchema()}
+	case "map":
+		m := map[string]any{"type": "object", "additionalProperties": t.Elem.jsonSchema()}
+		if t.Key == "integer" {
+			m["propertyNames"] = map[string]any{"pattern": "^-?[0-9]+$"}
+		}
+		return m
+	case "object":
+		return map[string]any{"$ref": "#/$defs/" + t.Ref}
+	}
+	return map[string]any{"type": t.Kind}
+}
+
+// ===== TypeScript =====
+
+// 生成TypeScript类型定义
+func (s *Schema) TypeScript() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "// 由 `websocket_server schema ts` 生成，请勿手动修改\n\n")
+	fmt.Fprintf(b, "export const PROTOCOL_VERSION = %d;\n\n", s.Version)
+	tsEnum(b, "ClientAction", s.opConsts())
+	tsEnum(b, "ClientErrorCode", s.Errors)
+	for _, t := range s.Types {
+		tsDoc(b, "", t.Doc)
+		fmt.Fprintf(b, "export interface %s {\n", t.Name)
+		for _, f := range t.Fields {
+			tsDoc(b, "  ", f.Doc)
+			optional := ""
+			if f.Optional {
+				optional = "?"
+			}
+			fmt.Fprintf(b, "  %s%s: %s;\n", f.Name, optional, f.Type.typeScript())
+		}
+		fmt.Fprintf(b, "}\n\n")
+	}
+	payloads := []struct {
+		name string
+		doc  string
+		get  func(op *OpSchema) *TypeRef
+	}{
+		{"RequestPayloads", "客户端请求的data", func(op *OpSchema) *TypeRef { return op.Request }},
+		{"ReplyPayloads", "服务器直接回复的data", func(op *OpSchema) *TypeRef { return op.Reply }},
+		{"EventPayloads", "服务器推送事件的data", func(op *OpSchema) *TypeRef { return op.Event }},
+	}
+	for _, p := range payloads {
+		tsDoc(b, "", p.doc)
+		fmt.Fprintf(b, "export interface %s {\n", p.name)
+		for _, op := range s.Ops {
+			if t := p.get(op); t != nil {
+				fmt.Fprintf(b, "  [ClientAction.%s]: %s;\n", op.Name, t.typeScript())
+			}
+		}
+		fmt.Fprintf(b, "}\n\n")
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+func tsEnum(b *strings.Builder, name string, values []*ConstSchema) {
+	fmt.Fprintf(b, "export enum %s {\n", name)
+	for _, v := range values {
+		tsDoc(b, "  ", v.Doc)
+		fmt.Fprintf(b, "  %s = %d,\n", v.Name, v.Value)
+	}
+	fmt.Fprintf(b, "}\n\n")
+}
+
+func tsDoc(b *strings.Builder, indent string, doc string) {
+	if doc != "" {
+		fmt.Fprintf(b, "%s/** %s */\n", indent, strings.ReplaceAll(doc, "*/", "* /"))
+	}
+}
+
+func (t *TypeRef) typeScript() string {
+	switch t.Kind {
+	case "integer", "number":
+		return "number"
+	case "array":
+		return "Array<" + t.Elem.typeScript() + ">"
+	case "map":
+		key := "string"
+		if t.Key == "integer" {
+			key = "number"
+		}
+		return "Record<" + key + ", " + t.Elem.typeScript() + ">"
+	case "object":
+		return t.Ref
+	case "string", "boolean":
+		return t.Kind
+	}
+	return "any"
+}
+
+// ===== Haxe =====
+
+// 生成Haxe类型定义（hxonline包）
+func (s *Schema) Haxe() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "// 由 `websocket_server schema haxe` 生成，请勿手动修改\n")
+	fmt.Fprintf(b, "package hxonline;\n\n")
+	fmt.Fprintf(b, "class Protocol {\n\tpublic static inline var VERSION:Int = %d;\n}\n\n", s.Version)
+	hxEnum(b, "ClientAction", s.opConsts())
+	hxEnum(b, "ClientErrorCode", s.Errors)
+	for _, t := range s.Types {
+		hxDoc(b, "", t.Doc)
+		fmt.Fprintf(b, "typedef %s = {\n", t.Name)
+		for _, f := range t.Fields {
+			hxDoc(b, "\t", f.Doc)
+			optional := ""
+			if f.Optional {
+				optional = "@:optional "
+			}
+			fmt.Fprintf(b, "\t%svar %s:%s;\n", optional, f.Name, f.Type.haxe())
+		}
+		fmt.Fprintf(b, "}\n\n")
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+func hxEnum(b *strings.Builder, name string, values []*ConstSchema) {
+	fmt.Fprintf(b, "enum abstract %s(Int) from Int to Int {\n", name)
+	for _, v := range values {
+		hxDoc(b, "\t", v.Doc)
+		fmt.Fprintf(b, "\tvar %s = %d;\n", v.Name, v.Value)
+	}
+	fmt.Fprintf(b, "}\n\n")
+}
+
+func hxDoc(b *strings.Builder, indent string, doc string) {
+	if doc != "" {
+		fmt.Fprintf(b, "%s/** %s **/\n", indent, strings.ReplaceAll(doc, "*/", "* /"))
+	}
+}
+
+func (t *TypeRef) haxe() string {
+	switch t.Kind {
+	case "integer":
+		return "Int"
+	case "number":
+		return "Float"
+	case "string":
+		return "String"
+	case "boolean":
+		return "Bool"
+	case "array":
+		return "Array<" + t.Elem.haxe() + ">"
+	case "map":
+		// JSON解析后的对象key均为字符串
+		return "haxe.DynamicAccess<" + t.Elem.haxe() + ">"
+	case "object":
+		return t.Ref
+	}
+	return "Dynamic"
+}
+
+// op常量列表
+func (s *Schema) opConsts() []*ConstSchema {
+	values := make([]*ConstSchema, len(s.Ops))
+	for i, op := range s.Ops {
+		values[i] = &op.ConstSchema
+	}
+	return values
+}
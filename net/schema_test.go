@@ -0,0 +1,35 @@
+package net
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// 生成的协议描述需要与docs/schema中提交的文件一致，修改op或data结构后执行`go generate`更新
+func TestGenerateSchemaGolden(t *testing.T) {
+	tests := []struct {
+		format string
+		file   string
+	}{
+		{"json", "hxonline.schema.json"},
+		{"ts", "hxonline.ts"},
+		{"haxe", "Protocol.hx"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := GenerateSchema(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := os.ReadFile(filepath.Join("..", "docs", "schema", tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("docs/schema/%s已过期，请在项目根目录执行go generate更新", tt.file)
+			}
+		})
+	}
+}
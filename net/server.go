@@ -40,9 +40,9 @@ func (c *CallFunc) Call(client *Client, message *ClientMessage, data any) {
 	r := v[0].Interface()
 	e := v[1].Interface()
 	if e != nil {
-		client.SendError(OP_ERROR, message.Op, e.(error).Error())
+		client.ReplyError(message, OP_ERROR, e.(error).Error())
 	} else if r != nil {
-		client.SendToUserOp(&ClientMessage{
+		client.ReplyOp(message, &ClientMessage{
 			Op:   message.Op,
 			Data: r,
 		})
@@ -51,11 +51,17 @@ func (c *CallFunc) Call(client *Client, message *ClientMessage, data any) {
 
 // 服务器方法
 type Server struct {
-	apps             *util.Map            // 所有应用的管理网
-	ConnectCounts    int                  // 当前连接数
-	MaxConnectCounts int                  // 当前服务器最大连接数
-	ExtendsApi       map[string]*CallFunc // 扩展方法
-	OnClosedApi      map[string]*CallFunc // 客户端关闭扩展方法
+	apps             *util.Map                 // 所有应用的管理网
+	ConnectCounts    int                       // 当前连接数
+	MaxConnectCounts int                       // 当前服务器最大连接数
+	ExtendsApi       map[string]*CallFunc      // 扩展方法
+	OnClosedApi      map[string]*CallFunc      // 客户端关闭扩展方法
+	appOptions       *util.Map                 // 应用配置（AppId -> *AppOption）
+	sessions         *util.Map                 // 可恢复会话（Token -> *Client）
+	sseConns         *util.Map                 // SSE连接（连接ID -> *websocketv2.SSEConn）
+	ReplayDir        string                    // 回放文件保存目录，为空时不录制回放
+	FrameSpillDir    string                    // 帧历史溢出文件的目录，为空时使用系统临时目录
+	frameValidators  map[string]FrameValidator // 帧操作校验器（扩展的类型名称 -> 校验器）
 }
 
 // 扩展注册
@@ -82,6 +88,9 @@ func (s *Server) Register(extendsApi any) {
 				Api:    extendsApi,
 				Method: method,
 			}
+		case "ValidateFrame":
+			// 帧操作校验器，房间通过类型名称启用
+			s.registerFrameValidator(tName, extendsApi)
 		default:
 			// 注册方法
 			s.ExtendsApi[id] = &CallFunc{
@@ -102,18 +111,30 @@ func (s *Server) InitServer() {
 	CurrentServer = s
 	s.ExtendsApi = map[string]*CallFunc{}
 	s.OnClosedApi = map[string]*CallFunc{}
+	s.frameValidators = map[string]FrameValidator{}
 	s.apps = util.CreateMap()
+	s.appOptions = util.CreateMap()
+	s.sessions = util.CreateMap()
+	s.sseConns = util.CreateMap()
+	// 连接关闭时需要通过Hub注销，在接受任何连接之前创建
+	websocketv2.Init()
+	go s.pingLoop()
 }
 
 // 开始侦听WebSocket服务器（ws）
 func (s *Server) Listen(ip string, port int) {
 	s.InitServer()
 	fmt.Println("[WS]Server start:" + ip + ":" + fmt.Sprint(port))
-	go websocketv2.Init()
 	httpServer := gin.Default()
 	httpServer.Any("/", upgradeToWebsocket)
 	httpServer.GET("/hxonline", upgradeToWebsocket)
 	httpServer.GET("/hxonline/v2", upgradeToWebsocket)
+	httpServer.GET("/hxonline/sse", sseConnect)
+	httpServer.POST("/hxonline/sse/:conn", ssePost)
+	httpServer.OPTIONS("/hxonline/sse/:conn", ssePreflight)
+	httpServer.GET("/hxonline/replays", listReplays)
+	httpServer.GET("/hxonline/replays/:id", downloadReplay)
+	httpServer.GET("/hxonline/ticks", listTickStats)
 	httpServer.GET("/hello", healthCheck)
 	if err := httpServer.Run(ip + ":" + fmt.Sprint(port)); err != nil {
 		logs.FatalF("服务器启动失败: %v", err)
@@ -124,11 +145,16 @@ func (s *Server) Listen(ip string, port int) {
 func (s *Server) ListenTLS(ip string, port int) {
 	s.InitServer()
 	fmt.Println("[WS]Server start:" + ip + ":" + fmt.Sprint(port))
-	go websocketv2.Init()
 	httpServer := gin.Default()
 	httpServer.Any("/", upgradeToWebsocket)
 	httpServer.GET("/hxonline", upgradeToWebsocket)
 	httpServer.GET("/hxonline/v2", upgradeToWebsocket)
+	httpServer.GET("/hxonline/sse", sseConnect)
+	httpServer.POST("/hxonline/sse/:conn", ssePost)
+	httpServer.OPTIONS("/hxonline/sse/:conn", ssePreflight)
+	httpServer.GET("/hxonline/replays", listReplays)
+	httpServer.GET("/hxonline/replays/:id", downloadReplay)
+	httpServer.GET("/hxonline/ticks", listTickStats)
 	httpServer.GET("/hello", healthCheck)
 	if err := httpServer.RunTLS(ip+":"+fmt.Sprint(port), "tls.pem", "tls.key"); err != nil {
 		logs.FatalF("服务器TLS启动失败: %v", err)
@@ -138,6 +164,8 @@ func (s *Server) ListenTLS(ip string, port int) {
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  4096,
 	WriteBufferSize: 4096,
+	// 支持permessage-deflate压缩协商，是否实际压缩由应用配置决定
+	EnableCompression: true,
 	// 解决跨域问题
 	CheckOrigin: func(r *http.Request) bool {
 		return true
@@ -173,7 +201,9 @@ func (s *Server) PushUser(c *Client) {
 func (s *Server) getApp(appid string) *App {
 	app := s.apps.GetData(appid, nil)
 	if app == nil {
-		app = &App{}
+		app = &App{
+			option: s.getAppOption(appid),
+		}
 		app.(*App).initApp()
 		s.apps.Store(appid, app)
 	}
@@ -181,15 +211,16 @@ func (s *Server) getApp(appid string) *App {
 }
 
 type App struct {
-	users              *util.Array  // 用户列表
-	rooms              *util.Array  // 房间列表
-	matchs             *Matchs      // 匹配管理
-	usersSQL           *UserDataSQL // 用户数据库，管理已注册、登陆的用户基础信息
-	msglist            *util.Array                       // 全服消息列表
-	listeners          map[ClientAction]*util.Array        // 统一通知侦听表（按OP分组）
-	nextRoomId         int64        // 下一个新房间ID（无复用ID时使用）
-	freedRoomIds       []int        // 可复用的已释放房间ID栈
-	roomIdMu           sync.Mutex   // 保护 freedRoomIds
+	users        *util.Array                  // 用户列表
+	rooms        *util.Array                  // 房间列表
+	matchs       *Matchs                      // 匹配管理
+	usersSQL     *UserDataSQL                 // 用户数据库，管理已注册、登陆的用户基础信息
+	msglist      *util.Array                  // 全服消息列表
+	listeners    map[ClientAction]*util.Array // 统一通知侦听表（按OP分组）
+	nextRoomId   int64                        // 下一个新房间ID（无复用ID时使用）
+	freedRoomIds []int                        // 可复用的已释放房间ID栈
+	roomIdMu     sync.Mutex                   // 保护 freedRoomIds
+	option       *AppOption                   // 应用配置
 }
 
 // 初始化App
@@ -238,6 +269,10 @@ func (s *App) removeRoom(room *Room) {
 	room.removeMu.Unlock()
 
 	s.rooms.Remove(room)
+	room.clearSpectators()
+	room.frameMu.Lock()
+	room.history.close()
+	room.frameMu.Unlock()
 	s.recycleRoomId(room.id)
 	s.broadcastRoomListChanged()
 }
@@ -305,13 +340,16 @@ func (s *App) GetServerMsg(user *Client, newCounts int) {
 
 }
 
-
 // 统一通知（按OP向所有订阅者推送）
 func (s *App) notifyListeners(op ClientAction, data any, excludeUser ...*Client) {
 	arr, ok := s.listeners[op]
 	if !ok {
 		return
 	}
+	p := NewPreparedMessage(&ClientMessage{
+		Op:   op,
+		Data: data,
+	})
 	for _, v := range arr.List {
 		u := v.(*Client)
 		skip := false
@@ -322,10 +360,7 @@ func (s *App) notifyListeners(op ClientAction, data any, excludeUser ...*Client)
 			}
 		}
 		if !skip {
-			u.SendToUserOp(&ClientMessage{
-				Op:   op,
-				Data: data,
-			})
+			u.SendPrepared(p)
 		}
 	}
 }
@@ -348,34 +383,41 @@ func (s *App) CreateRoom(user *Client, option RoomConfigOption) *Room {
 	// 帧率：默认 30 FPS，允许客户端自定义（最低 1，最高 120，0 使用默认值）
 	fps := option.fps
 	if fps <= 0 {
-		fps = 30
-	} else if fps < 1 {
-		fps = 1
-	} else if fps > 120 {
-		fps = 120
+		fps = defaultFPS
+	} else if fps < minFPS {
+		fps = minFPS
+	} else if fps > maxFPS {
+		fps = maxFPS
 	}
 	interval := float64(time.Second) / fps
 
 	// 如果房间没有定义最大人数，则默认为10个
 	if option.maxCounts == 0 {
-		option.maxCounts = 10
-	} else if option.maxCounts > 100 {
-		option.maxCounts = 100
+		option.maxCounts = defaultRoomCounts
+	} else if option.maxCounts > maxRoomCounts {
+		option.maxCounts = maxRoomCounts
+	}
+	if option.maxSpectators > maxSpectatorCounts {
+		option.maxSpectators = maxSpectatorCounts
 	}
+	option.normalizeInput()
 
 	room := Room{
-		id:        create_uid,
-		master:    user,
-		interval:  time.Duration(interval),
-		option:    &option,
-		users:     util.CreateArray(),
-		oldMsgs:   util.CreateArray(),
-		userState: map[int]*ClientState{},
+		id:         create_uid,
+		master:     user,
+		interval:   time.Duration(interval),
+		option:     &option,
+		users:      util.CreateArray(),
+		spectators: util.CreateArray(),
+		oldMsgs:    util.CreateArray(),
+		userState:  map[int]*ClientState{},
 		roomState: &ClientState{
 			Data: util.CreateMap(),
 		},
 		customData: util.CreateMap(),
-		frameDatas: util.CreateArray(),
+		history:    newFrameHistory(s.option),
+		checksums:  newChecksumState(),
+		desyncs:    util.CreateArray(),
 	}
 	s.rooms.Push(&room)
 	room.JoinClient(user)
@@ -428,7 +470,7 @@ func (s *App) TryExitRoom(c *Client) {
 		room := c.room
 		for _, v := range room.users.List {
 			client := v.(*Client)
-			if client != c && client.Connected {
+			if client != c && client.isOnline() {
 				logs.InfoM("Try Exit Room Exist Client...", client.name)
 				hasConnected = true
 				break
@@ -471,13 +513,15 @@ func (s *App) MatchRoom(c *Client) (*Room, error) {
 
 // 房间的基础信息
 type RoomInfo struct {
-	Id        int    `json:"id"`        // 房间id
-	Counts    int    `json:"counts"`    // 当前人数
-	MaxCounts int    `json:"maxCounts"` // 最大人数
-	Password  bool   `json:"password"`  // 是否存在密码
-	Master    string `json:"master"`    // 房主名称
-	Lock      bool   `json:"lock"`      // 房间是否已锁定
-	Data      any    `json:"data"`      // 对应customData数据
+	Id            int    `json:"id"`            // 房间id
+	Counts        int    `json:"counts"`        // 当前人数
+	MaxCounts     int    `json:"maxCounts"`     // 最大人数
+	Spectators    int    `json:"spectators"`    // 当前观战人数
+	MaxSpectators int    `json:"maxSpectators"` // 观战人数上限
+	Password      bool   `json:"password"`      // 是否存在密码
+	Master        string `json:"master"`        // 房主名称
+	Lock          bool   `json:"lock"`          // 房间是否已锁定
+	Data          any    `json:"data"`          // 对应customData数据
 }
 
 // 获取指定范围的房间列表状态（仅返回房间当前人数、房间ID、是否有密码等基础信息）
@@ -500,13 +544,15 @@ func (s *App) GetRoomList(page int, counts int) any {
 			for _, v := range list {
 				r := v.(*Room)
 				arr = append(arr, RoomInfo{
-					Id:        r.id,
-					Counts:    r.users.Length(),
-					MaxCounts: r.option.maxCounts,
-					Password:  r.option.password != "",
-					Master:    r.master.name,
-					Lock:      r.lock,
-					Data:      r.customData.Copy(),
+					Id:            r.id,
+					Counts:        r.users.Length(),
+					MaxCounts:     r.option.maxCounts,
+					Spectators:    r.spectators.Length(),
+					MaxSpectators: r.option.maxSpectators,
+					Password:      r.option.password != "",
+					Master:        r.master.name,
+					Lock:          r.lock,
+					Data:          r.customData.Copy(),
 				})
 			}
 			return arr
@@ -516,23 +562,11 @@ func (s *App) GetRoomList(page int, counts int) any {
 }
 
 // 根据房间ID查询房间列表
-func (s *App) GetQueryRoomList(roomids []any) any {
+func (s *App) GetQueryRoomList(roomids []int) any {
 	hasId := func(id int) bool {
 		for _, v := range roomids {
-			// 安全处理 JSON 反序列化的多种数字类型
-			switch val := v.(type) {
-			case float64:
-				if val == float64(id) {
-					return true
-				}
-			case int:
-				if val == id {
-					return true
-				}
-			case int64:
-				if int(val) == id {
-					return true
-				}
+			if v == id {
+				return true
 			}
 		}
 		return false
@@ -544,13 +578,15 @@ func (s *App) GetQueryRoomList(roomids []any) any {
 			r := v.(*Room)
 			if hasId(r.id) {
 				arr = append(arr, RoomInfo{
-					Id:        r.id,
-					Counts:    r.users.Length(),
-					MaxCounts: r.option.maxCounts,
-					Password:  r.option.password != "",
-					Master:    r.master.name,
-					Lock:      r.lock,
-					Data:      r.customData.Copy(),
+					Id:            r.id,
+					Counts:        r.users.Length(),
+					MaxCounts:     r.option.maxCounts,
+					Spectators:    r.spectators.Length(),
+					MaxSpectators: r.option.maxSpectators,
+					Password:      r.option.password != "",
+					Master:        r.master.name,
+					Lock:          r.lock,
+					Data:          r.customData.Copy(),
 				})
 			}
 		}
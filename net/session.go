@@ -0,0 +1,170 @@
+package net
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+	"websocket_server/logs"
+)
+
+// 可恢复会话：登录后为连接分配会话Token，所有下发消息按序号递增编号并保存在回放缓冲区中，
+// 连接断开后在恢复窗口内可通过`ResumeSession`携带Token与最后收到的序号恢复，并补发缺失的消息
+type Session struct {
+	token     string           // 会话Token
+	lock      sync.Mutex       // 保护序号、缓冲区以及挂起状态
+	seq       int              // 最后一条下发消息的序号
+	buffer    []*ClientMessage // 回放缓冲区（按序号递增）
+	size      int              // 回放缓冲区的最大消息数
+	suspended bool             // 连接已断开，正在等待恢复
+	ended     bool             // 会话已结束（恢复超时或用户离线），不能再恢复
+	timer     *time.Timer      // 等待恢复的超时计时器
+}
+
+// 生成会话Token
+func createSessionToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// 为用户开启可恢复会话（应用配置的恢复窗口为0时不开启）
+func (c *Client) startSession() {
+	option := c.getApp().option
+	if option.ResumeWindow <= 0 {
+		return
+	}
+	c.session = &Session{
+		token: createSessionToken(),
+		size:  option.ReplayBufferSize,
+	}
+	CurrentServer.sessions.Store(c.session.token, c)
+}
+
+// 记录下发的消息，分配序号并写入回放缓冲区，返回分配的序号
+func (s *Session) record(message *ClientMessage) int {
+	s.seq++
+	value := *message
+	value.Seq = s.seq
+	s.buffer = append(s.buffer, &value)
+	if len(s.buffer) > s.size {
+		s.buffer = s.buffer[len(s.buffer)-s.size:]
+	}
+	return s.seq
+}
+
+// 获取序号大于seq的所有消息，如果缓冲区已无法覆盖，则返回错误
+func (s *Session) missed(seq int) ([]*ClientMessage, error) {
+	if seq > s.seq || seq < 0 {
+		return nil, fmt.Errorf("无效的消息序号%d", seq)
+	}
+	if seq == s.seq {
+		return nil, nil
+	}
+	if len(s.buffer) == 0 || s.buffer[0].Seq > seq+1 {
+		return nil, fmt.Errorf("消息序号%d已超出回放范围，请重新登录", seq)
+	}
+	return s.buffer[seq+1-s.buffer[0].Seq:], nil
+}
+
+// 连接断开时挂起会话，在恢复窗口内等待客户端恢复，返回false表示没有可恢复的会话
+func (c *Client) suspendSession() bool {
+	s := c.session
+	if s == nil {
+		return false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.suspended = true
+	s.timer = time.AfterFunc(c.getApp().option.ResumeWindow, func() {
+		logs.InfoM("用户" + c.name + "会话恢复超时")
+		c.endSession()
+	})
+	logs.InfoM("用户" + c.name + "连接断开，等待恢复会话")
+	return true
+}
+
+// 结束会话，如果会话处于挂起状态，则执行离线处理
+func (c *Client) endSession() {
+	s := c.session
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	suspended := s.suspended
+	s.suspended = false
+	s.ended = true
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.lock.Unlock()
+	CurrentServer.sessions.Remove(s.token)
+	c.session = nil
+	if suspended {
+		c.onOffline()
+	}
+}
+
+// 会话是否处于挂起状态
+func (c *Client) isSuspended() bool {
+	s := c.session
+	if s == nil {
+		return false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.suspended
+}
+
+// 恢复会话：将新的连接绑定到原有的用户上，并补发序号大于seq的消息，房间内的其他成员不会感知到断线。
+// appid为客户端所属的应用，需要与会话所属的应用一致
+func (c *Client) resumeSession(appid string, token string, seq int) (*Client, error) {
+	v := CurrentServer.sessions.GetData(token, nil)
+	if v == nil {
+		return nil, fmt.Errorf("会话不存在或已过期")
+	}
+	old := v.(*Client)
+	if old.appid != appid {
+		return nil, fmt.Errorf("会话不存在或已过期")
+	}
+	s := old.session
+	if s == nil {
+		return nil, fmt.Errorf("会话不存在或已过期")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.ended {
+		return nil, fmt.Errorf("会话不存在或已过期")
+	}
+	messages, err := s.missed(seq)
+	if err != nil {
+		return nil, err
+	}
+	if s.suspended {
+		// 计时器已触发时，会话正在结束（等待会话锁），不能再恢复
+		if !s.timer.Stop() {
+			return nil, fmt.Errorf("会话不存在或已过期")
+		}
+		s.suspended = false
+	} else {
+		// 旧连接尚未检测到断开，直接关闭旧连接，且不触发离线处理
+		old.SetHandler(nil, nil)
+		old.Close()
+	}
+	// 将新连接绑定到原有用户上
+	old.Transport = c.Transport
+	old.codec = c.codec
+	old.SetHandler(old.OnMessage, old.OnUserOut)
+	old.applyConnOption()
+	old.setConnected(true)
+	// 补发缺失的消息（保持原有序号）
+	for _, m := range messages {
+		v, err := old.codec.Marshal(m)
+		if err == nil {
+			old.sendToUser(m.Op, v)
+		}
+	}
+	logs.InfoM("用户"+old.name+"恢复会话，补发消息数量：", len(messages))
+	return old, nil
+}
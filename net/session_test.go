@@ -0,0 +1,51 @@
+package net
+
+import "testing"
+
+// 恢复会话时补发连续收到的最大序号之后的所有消息，包括已通过优先通道收到的消息
+func TestSessionMissed(t *testing.T) {
+	s := &Session{size: 4}
+	for _, op := range []ClientAction{RoomMessage, FData, RoomMessage, FData, FData, RoomMessage} {
+		s.record(&ClientMessage{Op: op})
+	}
+	tests := []struct {
+		name  string
+		seq   int
+		first int // 补发的第一条消息序号，0表示不需要补发，-1表示失败
+	}{
+		{"全部已收到", 6, 0},
+		{"补发之后的消息", 4, 5},
+		{"缓冲区最早的消息", 2, 3},
+		{"超出回放范围", 1, -1},
+		{"序号无效", 7, -1},
+		{"负数序号", -1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages, err := s.missed(tt.seq)
+			if tt.first < 0 {
+				if err == nil {
+					t.Fatalf("got %d messages, want error", len(messages))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.first == 0 {
+				if len(messages) != 0 {
+					t.Fatalf("got %d messages, want none", len(messages))
+				}
+				return
+			}
+			if len(messages) != s.seq-tt.seq || messages[0].Seq != tt.first {
+				t.Fatalf("got %d messages from %d, want from %d", len(messages), messages[0].Seq, tt.first)
+			}
+			for i, m := range messages {
+				if m.Seq != tt.first+i {
+					t.Errorf("message %d seq = %d", i, m.Seq)
+				}
+			}
+		})
+	}
+}
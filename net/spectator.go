@@ -0,0 +1,197 @@
+package net
+
+import (
+	"fmt"
+	"time"
+	"websocket_server/logs"
+)
+
+// 观战用户无法执行的操作（上传帧数据、修改房间或用户状态、控制帧同步等）
+var spectatorDeniedOps = map[ClientAction]bool{
+	UploadFrame:                true,
+	SwitchSeat:                 true,
+	SetRoomState:               true,
+	SetClientState:             true,
+	StartFrameSync:             true,
+	StopFrameSync:              true,
+	StopFrameSyncWithoutUnlock: true,
+	ResetRoom:                  true,
+	ReportChecksum:             true,
+}
+
+// 房间的所有成员（玩家与观战用户），返回新的切片，遍历时不会影响原列表
+func (r *Room) members() []any {
+	list := make([]any, 0, r.users.Length()+r.spectators.Length())
+	list = append(list, r.users.List...)
+	return append(list, r.spectators.List...)
+}
+
+// 用户可以获取到的最新帧：玩家为当前帧，观战用户为已延迟下发的帧
+func (r *Room) visibleTick(c *Client) int {
+	if c.spectator {
+		return r.spectatorTick
+	}
+	return r.cacheId
+}
+
+// 以观战身份加入房间，观战不受房间锁定与人数限制，但受观战人数上限限制
+func (s *App) SpectateRoom(user *Client, roomid int, password string) (*Room, error) {
+	if user.room != nil {
+		return nil, fmt.Errorf("已存在房间")
+	}
+	for _, v := range s.rooms.List {
+		room := v.(*Room)
+		if room.id == roomid {
+			if room.option.maxSpectators == 0 {
+				return nil, fmt.Errorf("房间不允许观战")
+			}
+			if room.spectators.Length() >= room.option.maxSpectators {
+				return nil, fmt.Errorf("观战人数已满，无法进入")
+			}
+			if room.option.password != password {
+				return nil, fmt.Errorf("房间密码错误，无法进入")
+			}
+			room.JoinSpectator(user)
+			return room, nil
+		}
+	}
+	return nil, fmt.Errorf("无法找到" + fmt.Sprint(roomid) + "房间")
+}
+
+// 加入观战用户
+func (r *Room) JoinSpectator(client *Client) {
+	if client.room != nil {
+		return
+	}
+	r.spectators.Push(client)
+	client.room = r
+	client.spectator = true
+	logs.InfoM(client.name, "观战房间["+fmt.Sprint(r.id)+"]，当前观战人数：", r.spectators.Length())
+	client.SendToUserOp(&ClientMessage{
+		Op:   GetRoomData,
+		Data: r.GetRoomData(),
+	})
+	r.SendToAllUserOp(&ClientMessage{
+		Op:   JoinRoomClient,
+		Data: client.GetUserData(),
+	}, client)
+	r.onRoomChanged()
+	client.getApp().broadcastRoomListChanged()
+}
+
+// 观战用户退出
+func (r *Room) exitSpectator(client *Client) {
+	if !r.spectators.Remove(client) {
+		return
+	}
+	r.frameMu.Lock()
+	client.catchingUp = false
+	r.frameMu.Unlock()
+	client.room = nil
+	client.spectator = false
+	r.SendToAllUserOp(&ClientMessage{
+		Op:   ExitRoomClient,
+		Data: client.GetUserData(),
+	}, client)
+	r.onRoomChanged()
+	client.getApp().broadcastRoomListChanged()
+	logs.InfoM(client.name, "：退出观战["+fmt.Sprint(r.id)+"]，当前观战人数：", r.spectators.Length())
+}
+
+// 房间移除时，将所有观战用户移出房间
+func (r *Room) clearSpectators() {
+	for _, v := range append([]any{}, r.spectators.List...) {
+		c := v.(*Client)
+		r.spectators.Remove(c)
+		c.room = nil
+		c.spectator = false
+		c.SendToUserOp(&ClientMessage{
+			Op: SelfKickOut,
+		})
+	}
+}
+
+// 下发已超过观战延迟的帧，flush为true时不再等待延迟，下发所有剩余的帧。需要在帧锁内调用
+func (r *Room) pushSpectatorFrames(flush bool) {
+	if r.spectators.Length() == 0 {
+		// 没有观战用户时不需要下发，之后加入的观战用户通过追帧获取
+		r.spectatorTick = r.delayedTick(flush)
+		return
+	}
+	to := r.delayedTick(flush)
+	r.skipDiscardedFrames(to)
+	for r.spectatorTick < to {
+		r.spectatorTick++
+		d, err := r.history.get(r.spectatorTick)
+		if err != nil {
+			logs.InfoM("观战帧读取失败：", err)
+			continue
+		}
+		p := NewPreparedMessage(&ClientMessage{
+			Op: FData,
+			Data: map[string]any{
+				"t": r.spectatorTick,
+				"d": d,
+			},
+		})
+		for _, v := range r.spectators.List {
+			c := v.(*Client)
+			if !c.catchingUp {
+				c.SendPrepared(p)
+			}
+		}
+	}
+}
+
+// 跳过延迟期间已被清除（discard策略）的帧，并通知观战用户缺失的帧范围。
+// 连续缺失（观战延迟超过保留的帧数）时只在开始时通知一次。需要在帧锁内调用
+func (r *Room) skipDiscardedFrames(to int) {
+	oldest := r.history.oldest()
+	if r.spectatorTick >= oldest-1 || r.spectatorTick >= to {
+		return
+	}
+	from := r.spectatorTick + 1
+	r.spectatorTick = oldest - 1
+	if r.spectatorTick > to {
+		r.spectatorTick = to
+	}
+	continued := r.spectatorGap > 0 && from == r.spectatorGap+1
+	r.spectatorGap = r.spectatorTick
+	if continued {
+		return
+	}
+	p := NewPreparedMessage(&ClientMessage{
+		Op: Error,
+		Data: ClientError{
+			Code: FRAMES_DISCARDED,
+			Op:   FData,
+			Msg:  fmt.Sprintf("第%d帧起的帧已被清除，无法下发给观战用户", from),
+		},
+	})
+	for _, v := range r.spectators.List {
+		c := v.(*Client)
+		if !c.catchingUp {
+			c.SendPrepared(p)
+		}
+	}
+}
+
+// 已超过观战延迟的最新帧
+func (r *Room) delayedTick(flush bool) int {
+	if flush {
+		return r.cacheId
+	}
+	limit := time.Since(r.syncStart).Milliseconds() - int64(r.option.spectatorDelay)
+	tick := r.spectatorTick
+	// 已被清除（discard策略）的帧没有帧时间，视为已超过延迟，由skipDiscardedFrames通知观战用户
+	if evicted := r.history.oldest() - 1; tick < evicted {
+		tick = evicted
+	}
+	for tick < r.cacheId {
+		if ms, err := r.history.time(tick + 1); err != nil || ms > limit {
+			break
+		}
+		tick++
+	}
+	return tick
+}
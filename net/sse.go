@@ -0,0 +1,59 @@
+package net
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"websocket_server/logs"
+	"websocket_server/websocketv2"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 建立SSE连接（WebSocket不可用时的降级传输）：服务器通过事件流下发消息，
+// 客户端使用open事件返回的连接ID，通过`POST /hxonline/sse/:conn`上传消息
+func sseConnect(c *gin.Context) {
+	c.Header("Access-Control-Allow-Origin", "*")
+	id := createSessionToken()
+	conn := websocketv2.NewSSEConn(id)
+	CurrentServer.sseConns.Store(id, conn)
+	defer CurrentServer.sseConns.Remove(id)
+	logs.InfoM("sseConnect...", id)
+	CreateClient(websocketv2.CreateSSEClient(conn))
+	conn.Serve(c.Writer, c.Request)
+}
+
+// 上传客户端消息，请求体为一条JSON编码的ClientMessage，回复通过事件流下发
+func ssePost(c *gin.Context) {
+	c.Header("Access-Control-Allow-Origin", "*")
+	v := CurrentServer.sseConns.GetData(c.Param("conn"), nil)
+	if v == nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, websocketv2.MaxMessageSize))
+	if err != nil {
+		// 超出单条消息的大小限制时返回413，不截断后继续处理
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.Status(http.StatusRequestEntityTooLarge)
+		} else {
+			c.Status(http.StatusBadRequest)
+		}
+		return
+	}
+	if v.(*websocketv2.SSEConn).Post(data) != nil {
+		c.Status(http.StatusGone)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// 上传消息的CORS预检请求：跨域POST带有Content-Type时，浏览器会先发送OPTIONS请求
+func ssePreflight(c *gin.Context) {
+	c.Header("Access-Control-Allow-Origin", "*")
+	c.Header("Access-Control-Allow-Methods", "POST, OPTIONS")
+	c.Header("Access-Control-Allow-Headers", "Content-Type")
+	c.Header("Access-Control-Max-Age", "86400")
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,84 @@
+package net
+
+import (
+	"sync/atomic"
+	"time"
+	"websocket_server/runtime"
+)
+
+// 服务器启动时间，单调时间以此为起点
+var serverStart = time.Now()
+
+// 应用层心跳的检查周期
+const pingCheckInterval = time.Second
+
+// 服务器的单调时间（毫秒），不受系统时间调整影响
+func monotonicMs() int64 {
+	return time.Since(serverStart).Milliseconds()
+}
+
+// 时间同步：返回服务器时间与当前帧，客户端可根据发送与收到回复的时间计算时钟偏差
+func (c *Client) timeSync(clientTime int64) *TimeSyncReply {
+	reply := &TimeSyncReply{
+		C:    clientTime,
+		Wall: time.Now().UnixMilli(),
+		Mono: monotonicMs(),
+		Rtt:  c.Rtt(),
+	}
+	if r := c.room; r != nil && r.frameSync {
+		reply.T = r.visibleTick(c)
+		reply.Interval = float64(r.frameInterval()) / float64(time.Millisecond)
+	}
+	return reply
+}
+
+// 平滑后的往返延迟（毫秒），0表示尚未测量
+func (c *Client) Rtt() int {
+	return int(time.Duration(atomic.LoadInt64(&c.rtt)) / time.Millisecond)
+}
+
+// 收到心跳回复，按发送时间计算往返延迟（与TCP相同，新样本占1/8）
+func (c *Client) onPong(sent int64) {
+	sample := time.Duration(monotonicMs()-sent) * time.Millisecond
+	if sample < 0 || sent <= 0 {
+		return
+	}
+	for {
+		old := atomic.LoadInt64(&c.rtt)
+		rtt := int64(sample)
+		if old > 0 {
+			rtt = old + (int64(sample)-old)/8
+		}
+		if atomic.CompareAndSwapInt64(&c.rtt, old, rtt) {
+			return
+		}
+	}
+}
+
+// 按应用配置的间隔给已登录的用户发送应用层心跳，用于测量往返延迟（适用于所有传输方式）
+func (s *Server) pingLoop() {
+	defer runtime.GoRecover()
+	for range time.Tick(pingCheckInterval) {
+		for _, v := range s.apps.Copy() {
+			app := v.(*App)
+			interval := app.option.PingInterval
+			if interval <= 0 {
+				continue
+			}
+			now := monotonicMs()
+			// 用户列表由连接协程修改，遍历副本
+			for _, u := range app.users.Copy() {
+				c := u.(*Client)
+				// 旧版本客户端不支持心跳op
+				if !c.isConnected() || c.version < opVersions[Pong] || now-atomic.LoadInt64(&c.pingAt) < interval.Milliseconds() {
+					continue
+				}
+				atomic.StoreInt64(&c.pingAt, now)
+				c.SendToUserOp(&ClientMessage{
+					Op:   Ping,
+					Data: &PingEvent{S: now},
+				})
+			}
+		}
+	}
+}
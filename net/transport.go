@@ -0,0 +1,68 @@
+package net
+
+import (
+	"errors"
+	"fmt"
+	stdnet "net"
+	"time"
+	"websocket_server/logs"
+	"websocket_server/websocketv2"
+)
+
+// 传输层连接，Client通过该接口收发消息，与具体的传输方式（WebSocket、TCP、SSE）无关，
+// 不同传输方式的用户共用同一套App、房间与匹配逻辑
+type Transport interface {
+	Start()                                                // 开始收发消息
+	SetHandler(onData func(data []byte), onClose func())   // 设置消息处理与连接断开的回调
+	SendBytes(data []byte)                                 // 发送消息（非阻塞）
+	SendBytesPriority(data []byte)                         // 发送高优先级消息（非阻塞）
+	Close()                                                // 关闭连接（幂等）
+	Subprotocol() string                                   // 协商的编解码器名称
+	SetBinary(binary bool)                                 // 设置是否以二进制帧发送
+	SetCompression(enabled bool, threshold int)            // 设置压缩
+	SetBatcher(batcher func(messages [][]byte) []byte)     // 设置消息合并器
+	SetBackpressure(policy websocketv2.BackpressurePolicy) // 设置背压策略
+	SetDropNotice(notice func(count int) []byte)           // 设置丢弃消息的通知
+	Dropped() int64                                        // 累计丢弃的消息数量
+	IsLoginTimeout(timeout time.Duration) bool             // 是否超过登录等待时间
+}
+
+// 开始侦听TCP服务器，消息格式为4字节大端长度 + 编码后的ClientMessage
+func (s *Server) ListenTCP(ip string, port int) {
+	s.InitServer()
+	fmt.Println("[TCP]Server start:" + ip + ":" + fmt.Sprint(port))
+	l, err := stdnet.Listen("tcp", ip+":"+fmt.Sprint(port))
+	if err != nil {
+		logs.FatalF("TCP服务器启动失败: %v", err)
+		return
+	}
+	s.ServeStream(l)
+}
+
+// 在流式侦听器上提供服务（阻塞），每个连接按长度前缀分帧，可用于TCP等实现了net.Listener的侦听器。
+// 需要先调用InitServer
+func (s *Server) ServeStream(l stdnet.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, stdnet.ErrClosed) {
+				return
+			}
+			logs.ErrorF("accept error: %v", err)
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		go serveStreamConn(conn)
+	}
+}
+
+// 处理流式连接：读取第一帧识别编解码器后创建客户端
+func serveStreamConn(conn stdnet.Conn) {
+	client, err := websocketv2.CreateStreamClient(conn, subprotocols)
+	if err != nil {
+		logs.ErrorF("stream handshake error: %v", err)
+		return
+	}
+	logs.InfoM("acceptStream...", conn.RemoteAddr().String())
+	CreateClient(client)
+}
@@ -0,0 +1,209 @@
+package net
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	stdnet "net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"websocket_server/websocketv2"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	jsoniter "github.com/json-iterator/go"
+)
+
+var (
+	testServerOnce sync.Once
+	testTCPAddr    string
+	testWSURL      string
+	testUsers      int64
+)
+
+// 每次测试使用新的用户，避免重复执行时沿用上一次的房间
+func testOpenId(name string) string {
+	return fmt.Sprintf("%s-%d", name, atomic.AddInt64(&testUsers, 1))
+}
+
+// 启动测试用的服务器（进程内只启动一次）：TCP侦听器与WebSocket服务器共用同一个Server
+func startTestServer(t *testing.T) (string, string) {
+	t.Helper()
+	testServerOnce.Do(func() {
+		s := &Server{}
+		s.InitServer()
+		l, err := stdnet.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		go s.ServeStream(l)
+		gin.SetMode(gin.TestMode)
+		engine := gin.New()
+		engine.GET("/hxonline", upgradeToWebsocket)
+		ws := httptest.NewServer(engine)
+		testTCPAddr = l.Addr().String()
+		testWSURL = "ws" + strings.TrimPrefix(ws.URL, "http") + "/hxonline"
+	})
+	return testTCPAddr, testWSURL
+}
+
+// 测试客户端，统一TCP与WebSocket的收发
+type testConn struct {
+	t    *testing.T
+	send func(data []byte) error
+	recv func() ([]byte, error)
+}
+
+func dialTCP(t *testing.T, addr string) *testConn {
+	conn, err := stdnet.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return &testConn{
+		t: t,
+		send: func(data []byte) error {
+			frame := make([]byte, 4+len(data))
+			binary.BigEndian.PutUint32(frame, uint32(len(data)))
+			copy(frame[4:], data)
+			_, err := conn.Write(frame)
+			return err
+		},
+		recv: func() ([]byte, error) {
+			conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+			header := make([]byte, 4)
+			if _, err := io.ReadFull(conn, header); err != nil {
+				return nil, err
+			}
+			data := make([]byte, binary.BigEndian.Uint32(header))
+			_, err := io.ReadFull(conn, data)
+			return data, err
+		},
+	}
+}
+
+func dialWS(t *testing.T, url string) *testConn {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return &testConn{
+		t: t,
+		send: func(data []byte) error {
+			return conn.WriteMessage(websocket.TextMessage, data)
+		},
+		recv: func() ([]byte, error) {
+			conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+			_, data, err := conn.ReadMessage()
+			return data, err
+		},
+	}
+}
+
+func (c *testConn) write(message *ClientMessage) {
+	c.t.Helper()
+	data, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(message)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := c.send(data); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// 读取消息直到满足条件
+func (c *testConn) readUntil(match func(m *ClientMessage) bool) *ClientMessage {
+	c.t.Helper()
+	for {
+		data, err := c.recv()
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		m := &ClientMessage{}
+		if err := jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal(data, m); err != nil {
+			c.t.Fatal(err)
+		}
+		if m.Op == Error {
+			c.t.Fatalf("unexpected error: %v", m.Data)
+		}
+		if match(m) {
+			return m
+		}
+	}
+}
+
+func (c *testConn) reply(id int) *ClientMessage {
+	c.t.Helper()
+	return c.readUntil(func(m *ClientMessage) bool { return m.Id == id })
+}
+
+func (c *testConn) login(appid string, openid string) int {
+	c.t.Helper()
+	c.write(&ClientMessage{Op: Login, Id: 1, Data: map[string]any{"appid": appid, "openid": openid, "username": openid}})
+	data := c.reply(1).Data.(map[string]any)
+	return int(data["uid"].(float64))
+}
+
+// TCP与WebSocket用户共用同一套应用与房间：TCP用户创建房间，WebSocket用户加入后互相收发房间消息
+func TestTransportRoundTrip(t *testing.T) {
+	tcpAddr, wsURL := startTestServer(t)
+	tcp := dialTCP(t, tcpAddr)
+	ws := dialWS(t, wsURL)
+	tcp.login("transport", testOpenId("tcp"))
+	wsUid := ws.login("transport", testOpenId("ws"))
+
+	tcp.write(&ClientMessage{Op: CreateRoom, Id: 2, Data: map[string]any{}})
+	room := tcp.reply(2).Data.(map[string]any)
+	roomId := room["id"]
+
+	ws.write(&ClientMessage{Op: JoinRoom, Id: 3, Data: map[string]any{"id": roomId}})
+	ws.reply(3)
+	joined := tcp.readUntil(func(m *ClientMessage) bool { return m.Op == JoinRoomClient })
+	if uid := joined.Data.(map[string]any)["uid"]; uid != float64(wsUid) {
+		t.Fatalf("JoinRoomClient uid = %v, want %d", uid, wsUid)
+	}
+
+	ws.write(&ClientMessage{Op: RoomMessage, Id: 4, Data: "hello"})
+	got := tcp.readUntil(func(m *ClientMessage) bool { return m.Op == RoomMessage })
+	if data := got.Data.(map[string]any); data["data"] != "hello" || data["uid"] != float64(wsUid) {
+		t.Fatalf("RoomMessage = %v", data)
+	}
+}
+
+// SSE上传的请求体超过单条消息的大小限制时返回413，不截断后继续处理
+func TestSSEPostLimit(t *testing.T) {
+	startTestServer(t)
+	id := createSessionToken()
+	conn := websocketv2.NewSSEConn(id)
+	CurrentServer.sseConns.Store(id, conn)
+	defer CurrentServer.sseConns.Remove(id)
+	engine := gin.New()
+	engine.POST("/hxonline/sse/:conn", ssePost)
+	tests := []struct {
+		name string
+		size int
+		want int
+	}{
+		{"限制以内", websocketv2.MaxMessageSize, http.StatusNoContent},
+		{"超出限制", websocketv2.MaxMessageSize + 1, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/hxonline/sse/"+id, strings.NewReader(strings.Repeat("a", tt.size)))
+			engine.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+	if data, _ := conn.ReadFrame(); len(data) != websocketv2.MaxMessageSize {
+		t.Errorf("posted %d bytes, want %d", len(data), websocketv2.MaxMessageSize)
+	}
+}
@@ -29,11 +29,22 @@ func (u *UserDataSQL) login(c *Client, openId string, userName string) *Register
 			if user.client.room != nil {
 				// 如果原本就存在房间时，则需要把用户返回到房间中
 				r := user.client.room
+				spectator := user.client.spectator
 				r.ExitClient(user.client)
-				r.JoinClient(c)
+				if r.frameSync {
+					// 帧同步中重新加入时，登录完成后通过追帧补发缺失的帧
+					r.holdFrames(c)
+				}
+				if spectator {
+					r.JoinSpectator(c)
+				} else {
+					r.JoinClient(c)
+				}
 				logs.InfoM("该用户[" + user.client.name + "]仍然在房间中，加入房间")
 			}
 			logs.InfoM(user.client.name + "掉线处理")
+			// 旧连接的会话不再可恢复
+			user.client.endSession()
 			user.client.SendError(LOGIN_OUT_ERROR, Login, "用户已在其他地方登录")
 			user.client.Close()
 			user.userName = userName
@@ -0,0 +1,241 @@
+package net
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// 严格解析请求的data：字段类型必须与请求结构一致（不会把错误类型转换为0或空字符串），
+// 未声明omitempty的字段必须存在，并按`validate`标签校验取值范围，错误信息中包含字段路径（如data.range.level.min）
+//
+// 支持的校验规则（逗号分隔）：
+//   - min=N / max=N：数字的取值范围，字符串、数组的长度范围
+//   - nonempty：字符串、数组、对象不能为空
+//   - oneof=a b c：只能为列出的值之一（空格分隔）
+func decodeData(data any, v any) error {
+	return decodeValue(data, reflect.ValueOf(v).Elem(), "data")
+}
+
+// 按op声明的请求结构解析data，失败时回复DATA_ERROR
+func decodeRequest[T any](c *Client, message *ClientMessage) (*T, bool) {
+	v := new(T)
+	if err := decodeData(message.Data, v); err != nil {
+		c.ReplyError(message, DATA_ERROR, err.Error())
+		return nil, false
+	}
+	return v, true
+}
+
+func decodeValue(data any, v reflect.Value, path string) error {
+	if v.Kind() == reflect.Interface {
+		if data != nil {
+			v.Set(reflect.ValueOf(data))
+		}
+		return nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if data == nil {
+			return nil
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		return decodeValue(data, v.Elem(), path)
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		// 没有data时按空对象处理，由必填字段给出具体的错误
+		if data == nil {
+			data = map[string]any{}
+		}
+		m, ok := data.(map[string]any)
+		if !ok {
+			return typeError(path, "object")
+		}
+		return decodeStruct(m, v, path)
+	case reflect.Map:
+		if data == nil {
+			data = map[string]any{}
+		}
+		m, ok := data.(map[string]any)
+		if !ok {
+			return typeError(path, "object")
+		}
+		v.Set(reflect.MakeMapWithSize(v.Type(), len(m)))
+		for k, item := range m {
+			key := reflect.New(v.Type().Key()).Elem()
+			if key.Kind() == reflect.String {
+				key.SetString(k)
+			} else {
+				i, err := strconv.ParseInt(k, 10, 64)
+				if err != nil {
+					return fmt.Errorf("字段%s的key需要为整数：%s", path, k)
+				}
+				key.SetInt(i)
+			}
+			value := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeValue(item, value, path+"."+k); err != nil {
+				return err
+			}
+			v.SetMapIndex(key, value)
+		}
+	case reflect.Slice:
+		list, ok := data.([]any)
+		if !ok {
+			return typeError(path, "array")
+		}
+		v.Set(reflect.MakeSlice(v.Type(), len(list), len(list)))
+		for i, item := range list {
+			if err := decodeValue(item, v.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, ok := intValue(data, v.Kind() == reflect.Int64)
+		if !ok {
+			return typeError(path, "integer")
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, ok := intValue(data, v.Kind() == reflect.Uint64)
+		if !ok || i < 0 {
+			return typeError(path, "integer")
+		}
+		v.SetUint(uint64(i))
+	case reflect.Float32, reflect.Float64:
+		f, ok := data.(float64)
+		if !ok {
+			return typeError(path, "number")
+		}
+		v.SetFloat(f)
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			return typeError(path, "string")
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b, ok := data.(bool)
+		if !ok {
+			return typeError(path, "boolean")
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("字段%s的类型不支持解析", path)
+	}
+	return nil
+}
+
+// 解析整数，numeric为true时同时接受数字字符串（Protobuf的JSON映射中64位整数编码为字符串）
+func intValue(data any, numeric bool) (int64, bool) {
+	switch d := data.(type) {
+	case float64:
+		return int64(d), d == math.Trunc(d)
+	case string:
+		if !numeric {
+			return 0, false
+		}
+		i, err := strconv.ParseInt(d, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+func typeError(path string, want string) error {
+	return fmt.Errorf("字段%s的类型错误，需要%s", path, want)
+}
+
+// 解析结构体的每个字段，并校验必填字段与取值范围
+func decodeStruct(m map[string]any, v reflect.Value, path string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		name := tag[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		optional := false
+		for _, opt := range tag[1:] {
+			if opt == "omitempty" {
+				optional = true
+			}
+		}
+		fieldPath := path + "." + name
+		item, ok := m[name]
+		if !ok || item == nil {
+			if optional || f.Type.Kind() == reflect.Interface {
+				continue
+			}
+			return fmt.Errorf("缺少字段%s", fieldPath)
+		}
+		if err := decodeValue(item, v.Field(i), fieldPath); err != nil {
+			return err
+		}
+		if err := validateField(v.Field(i), f.Tag.Get("validate"), fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 按validate标签校验字段
+func validateField(v reflect.Value, rules string, path string) error {
+	if rules == "" {
+		return nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	for _, rule := range strings.Split(rules, ",") {
+		name, arg, _ := strings.Cut(rule, "=")
+		var size float64
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			size = float64(v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			size = float64(v.Uint())
+		case reflect.Float32, reflect.Float64:
+			size = v.Float()
+		case reflect.String, reflect.Slice, reflect.Map:
+			size = float64(v.Len())
+		}
+		switch name {
+		case "oneof":
+			values := strings.Fields(arg)
+			valid := false
+			for _, value := range values {
+				valid = valid || value == fmt.Sprint(v.Interface())
+			}
+			if !valid {
+				return fmt.Errorf("字段%s的值无效，可选值：%s", path, strings.Join(values, "、"))
+			}
+		case "nonempty":
+			if size == 0 {
+				return fmt.Errorf("字段%s不能为空", path)
+			}
+		case "min", "max":
+			limit, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return fmt.Errorf("字段%s的校验规则无效：%s", path, rule)
+			}
+			if name == "min" && size < limit {
+				return fmt.Errorf("字段%s不能小于%s", path, arg)
+			}
+			if name == "max" && size > limit {
+				return fmt.Errorf("字段%s不能大于%s", path, arg)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package net
+
+import (
+	"strings"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+type validateRange struct {
+	Min int `json:"min" validate:"min=1"`
+	Max int `json:"max,omitempty" validate:"max=10"`
+}
+
+type validateRequest struct {
+	Name  string            `json:"name" validate:"nonempty"`
+	Mode  string            `json:"mode,omitempty" validate:"oneof=free lockstep"`
+	Count *int              `json:"count,omitempty" validate:"min=0,max=5"`
+	Tags  []string          `json:"tags,omitempty" validate:"max=2"`
+	Range *validateRange    `json:"range,omitempty"`
+	Users map[int]string    `json:"users,omitempty"`
+	Rate  float64           `json:"rate,omitempty"`
+	Open  bool              `json:"open,omitempty"`
+	Big   int64             `json:"big,omitempty"`
+	Extra map[string]any    `json:"extra,omitempty"`
+	Data  any               `json:"data"`
+	Sub   []validateRange   `json:"sub,omitempty"`
+	Named map[string]string `json:"named,omitempty"`
+}
+
+func TestDecodeData(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string // 为空表示解析成功，否则为错误信息中应包含的内容
+	}{
+		{"最少字段", `{"name":"a"}`, ""},
+		{"没有data", `null`, "缺少字段data.name"},
+		{"缺少必填字段", `{}`, "缺少字段data.name"},
+		{"data不是对象", `[]`, "data的类型错误，需要object"},
+		{"字符串类型错误", `{"name":1}`, "data.name的类型错误，需要string"},
+		{"字符串不能为空", `{"name":""}`, "data.name不能为空"},
+		{"可选值", `{"name":"a","mode":"lockstep"}`, ""},
+		{"可选值无效", `{"name":"a","mode":"x"}`, "data.mode的值无效"},
+		{"整数", `{"name":"a","count":5}`, ""},
+		{"整数不能为小数", `{"name":"a","count":1.5}`, "data.count的类型错误，需要integer"},
+		{"整数不能为字符串", `{"name":"a","count":"1"}`, "data.count的类型错误，需要integer"},
+		{"整数超过最大值", `{"name":"a","count":6}`, "data.count不能大于5"},
+		{"整数小于最小值", `{"name":"a","count":-1}`, "data.count不能小于0"},
+		{"int64接受数字字符串", `{"name":"a","big":"9007199254740993"}`, ""},
+		{"int64字符串无效", `{"name":"a","big":"x"}`, "data.big的类型错误，需要integer"},
+		{"数组长度", `{"name":"a","tags":["x","y","z"]}`, "data.tags不能大于2"},
+		{"数组元素类型", `{"name":"a","tags":["x",1]}`, "data.tags[1]的类型错误，需要string"},
+		{"嵌套对象缺少字段", `{"name":"a","range":{}}`, "缺少字段data.range.min"},
+		{"嵌套对象取值范围", `{"name":"a","range":{"min":1,"max":11}}`, "data.range.max不能大于10"},
+		{"数组中的对象", `{"name":"a","sub":[{"min":1},{"min":0}]}`, "data.sub[1].min不能小于1"},
+		{"整数key", `{"name":"a","users":{"1":"x"}}`, ""},
+		{"整数key无效", `{"name":"a","users":{"x":"x"}}`, "data.users的key需要为整数：x"},
+		{"数字类型错误", `{"name":"a","rate":"1"}`, "data.rate的类型错误，需要number"},
+		{"布尔类型错误", `{"name":"a","open":1}`, "data.open的类型错误，需要boolean"},
+		{"任意类型", `{"name":"a","data":[1,"x"],"extra":{"a":{"b":1}}}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data any
+			if err := jsoniter.ConfigCompatibleWithStandardLibrary.UnmarshalFromString(tt.data, &data); err != nil {
+				t.Fatal(err)
+			}
+			v := validateRequest{}
+			err := decodeData(data, &v)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDecodeDataValues(t *testing.T) {
+	var data any
+	err := jsoniter.ConfigCompatibleWithStandardLibrary.UnmarshalFromString(
+		`{"name":"a","count":0,"big":"9007199254740993","users":{"2":"b"},"range":{"min":3}}`, &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := validateRequest{}
+	if err := decodeData(data, &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Count == nil || *v.Count != 0 {
+		t.Errorf("count = %v, want 0", v.Count)
+	}
+	if v.Big != 9007199254740993 {
+		t.Errorf("big = %d", v.Big)
+	}
+	if v.Users[2] != "b" {
+		t.Errorf("users = %v", v.Users)
+	}
+	if v.Range == nil || v.Range.Min != 3 {
+		t.Errorf("range = %+v", v.Range)
+	}
+}
@@ -0,0 +1,4954 @@
+// hxonline 协议定义
+//
+// 连接时通过WebSocket子协议`protobuf`协商使用。每条消息都是一个`Envelope`，
+// `Envelope.data`为对应op的负载消息的二进制内容：客户端发送的请求使用`*Request`消息，
+// 服务器下发的回复与事件使用`*Reply`/`*Event`消息，op与负载消息的对应关系见每个消息的注释。
+// 没有负载的op，data为空。
+//
+// 修改本文件后需重新生成 hxonline.pb.go（protoc --go_out=. --go_opt=paths=source_relative hxonline.proto）。
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: hxonline.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 消息信封
+type Envelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op   int32  `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`     // ClientAction
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`  // 负载消息
+	Id   int32  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`     // 请求ID（可选），服务器会在该请求的直接回复与错误中原样返回
+	Push bool   `protobuf:"varint,4,opt,name=push,proto3" json:"push,omitempty"` // 是否为服务器主动推送的事件
+	Seq  int32  `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`   // 下发消息的序号（开启可恢复会话后递增编号）
+	Tick int32  `protobuf:"varint,6,opt,name=tick,proto3" json:"tick,omitempty"` // UploadFrame：客户端期望该操作生效的帧序号（可选）
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetOp() int32 {
+	if x != nil {
+		return x.Op
+	}
+	return 0
+}
+
+func (x *Envelope) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Envelope) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Envelope) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+func (x *Envelope) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Envelope) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+// Batch(52) 合并下发的消息，messages中的每一项都是一个完整的Envelope，需按顺序处理
+type Batch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages [][]byte `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Batch) GetMessages() [][]byte {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// Error(-1) 错误信息
+type ClientError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"` // ClientErrorCode
+	Op   int32  `protobuf:"varint,2,opt,name=op,proto3" json:"op,omitempty"`     // 发生错误的op
+	Msg  string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`    // 错误信息
+}
+
+func (x *ClientError) Reset() {
+	*x = ClientError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientError) ProtoMessage() {}
+
+func (x *ClientError) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientError.ProtoReflect.Descriptor instead.
+func (*ClientError) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClientError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ClientError) GetOp() int32 {
+	if x != nil {
+		return x.Op
+	}
+	return 0
+}
+
+func (x *ClientError) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 用户数据，JoinRoomClient(11)、ExitRoomClient(12)、OutOnlineRoomClient(13)
+type UserData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       int32            `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name      string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Seat      int32            `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`           // 座位号（0=未分配）
+	Data      *structpb.Struct `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`            // 用户自定义数据
+	Spectator bool             `protobuf:"varint,5,opt,name=spectator,proto3" json:"spectator,omitempty"` // 是否为观战用户
+	Rtt       int32            `protobuf:"varint,6,opt,name=rtt,proto3" json:"rtt,omitempty"`             // 往返延迟（毫秒）
+}
+
+func (x *UserData) Reset() {
+	*x = UserData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserData) ProtoMessage() {}
+
+func (x *UserData) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserData.ProtoReflect.Descriptor instead.
+func (*UserData) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserData) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UserData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserData) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *UserData) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UserData) GetSpectator() bool {
+	if x != nil {
+		return x.Spectator
+	}
+	return false
+}
+
+func (x *UserData) GetRtt() int32 {
+	if x != nil {
+		return x.Rtt
+	}
+	return 0
+}
+
+// 携带uid的用户数据，RoomMessage(10)事件、UpdateRoomUserData(34)、ClientStateUpdate(26)、
+// UserMessage(45)、EVENT_GetServerMsg(37)
+type UserPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid  int32           `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Data *structpb.Value `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UserPayload) Reset() {
+	*x = UserPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPayload) ProtoMessage() {}
+
+func (x *UserPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPayload.ProtoReflect.Descriptor instead.
+func (*UserPayload) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserPayload) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UserPayload) GetData() *structpb.Value {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 房间ID，CreateRoom(1)、JoinRoom(2)、MatchRoom(32)回复
+type RoomId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RoomId) Reset() {
+	*x = RoomId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomId) ProtoMessage() {}
+
+func (x *RoomId) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomId.ProtoReflect.Descriptor instead.
+func (*RoomId) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoomId) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 匹配范围
+type MatchRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *MatchRange) Reset() {
+	*x = MatchRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRange) ProtoMessage() {}
+
+func (x *MatchRange) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRange.ProtoReflect.Descriptor instead.
+func (*MatchRange) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MatchRange) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MatchRange) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// 匹配参数，MatchUser(15)、MatchRoom(32)、SetRoomMatchOption(33)请求
+type MatchOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                                                                             // 匹配key，为空时忽略
+	Number int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`                                                                                      // 匹配所需的总人数
+	Range  map[string]*MatchRange `protobuf:"bytes,3,rep,name=range,proto3" json:"range,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 用户data参数的范围匹配
+	Fps    float64                `protobuf:"fixed64,4,opt,name=fps,proto3" json:"fps,omitempty"`                                                                                           // 帧同步帧率，0使用默认值30
+}
+
+func (x *MatchOption) Reset() {
+	*x = MatchOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchOption) ProtoMessage() {}
+
+func (x *MatchOption) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchOption.ProtoReflect.Descriptor instead.
+func (*MatchOption) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MatchOption) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MatchOption) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *MatchOption) GetRange() map[string]*MatchRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *MatchOption) GetFps() float64 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+// 房间基础信息
+type RoomInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int32            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Counts        int32            `protobuf:"varint,2,opt,name=counts,proto3" json:"counts,omitempty"`
+	MaxCounts     int32            `protobuf:"varint,3,opt,name=max_counts,json=maxCounts,proto3" json:"max_counts,omitempty"`
+	Password      bool             `protobuf:"varint,4,opt,name=password,proto3" json:"password,omitempty"` // 是否存在密码
+	Master        string           `protobuf:"bytes,5,opt,name=master,proto3" json:"master,omitempty"`      // 房主名称
+	Lock          bool             `protobuf:"varint,6,opt,name=lock,proto3" json:"lock,omitempty"`
+	Data          *structpb.Struct `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`                                         // 房间自定义数据
+	Spectators    int32            `protobuf:"varint,8,opt,name=spectators,proto3" json:"spectators,omitempty"`                            // 当前观战人数
+	MaxSpectators int32            `protobuf:"varint,9,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"` // 观战人数上限
+}
+
+func (x *RoomInfo) Reset() {
+	*x = RoomInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomInfo) ProtoMessage() {}
+
+func (x *RoomInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomInfo.ProtoReflect.Descriptor instead.
+func (*RoomInfo) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RoomInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetCounts() int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetMaxCounts() int32 {
+	if x != nil {
+		return x.MaxCounts
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetPassword() bool {
+	if x != nil {
+		return x.Password
+	}
+	return false
+}
+
+func (x *RoomInfo) GetMaster() string {
+	if x != nil {
+		return x.Master
+	}
+	return ""
+}
+
+func (x *RoomInfo) GetLock() bool {
+	if x != nil {
+		return x.Lock
+	}
+	return false
+}
+
+func (x *RoomInfo) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RoomInfo) GetSpectators() int32 {
+	if x != nil {
+		return x.Spectators
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
+// Login(8)
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Openid   string `protobuf:"bytes,1,opt,name=openid,proto3" json:"openid,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Appid    string `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`
+	Batch    bool   `protobuf:"varint,4,opt,name=batch,proto3" json:"batch,omitempty"`              // 是否开启消息合并下发（开启后可能收到Batch消息）
+	Version  int32  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`          // 客户端支持的协议版本，不传时为1
+	Compact  bool   `protobuf:"varint,6,opt,name=compact,proto3" json:"compact,omitempty"`          // 是否开启紧凑帧数据
+	NoAck    bool   `protobuf:"varint,7,opt,name=no_ack,json=noAck,proto3" json:"no_ack,omitempty"` // 上传帧数据时只在出错时回复
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LoginRequest) GetOpenid() string {
+	if x != nil {
+		return x.Openid
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+func (x *LoginRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LoginRequest) GetCompact() bool {
+	if x != nil {
+		return x.Compact
+	}
+	return false
+}
+
+func (x *LoginRequest) GetNoAck() bool {
+	if x != nil {
+		return x.NoAck
+	}
+	return false
+}
+
+// ResumeSession(51)
+type ResumeSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session string `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"` // 登录时返回的会话Token
+	Seq     int32  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`        // 连续收到的最大消息序号（该序号及之前的消息均已收到）
+	Appid   string `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`     // 应用ID，需要与登录时的应用一致
+}
+
+func (x *ResumeSessionRequest) Reset() {
+	*x = ResumeSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSessionRequest) ProtoMessage() {}
+
+func (x *ResumeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSessionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResumeSessionRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *ResumeSessionRequest) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ResumeSessionRequest) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+// 帧同步参数，StartFrameSync(5)的请求与FrameSyncReady(27)事件
+type FrameSyncOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode       string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                                // free、lockstep
+	InputDelay int32  `protobuf:"varint,2,opt,name=input_delay,json=inputDelay,proto3" json:"input_delay,omitempty"` // 锁步模式的输入延迟（帧）
+	Timeout    int32  `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                         // 锁步模式每帧等待玩家操作的超时时间（毫秒）
+	Straggler  string `protobuf:"bytes,4,opt,name=straggler,proto3" json:"straggler,omitempty"`                      // 锁步模式超时未提交操作的处理策略：fill、stall、drop
+	IdleFold   int32  `protobuf:"varint,5,opt,name=idle_fold,json=idleFold,proto3" json:"idle_fold,omitempty"`       // 紧凑帧数据最多合并的连续空帧数
+	Late       string `protobuf:"bytes,6,opt,name=late,proto3" json:"late,omitempty"`                                // 操作晚于期望的帧到达时的处理策略：shift、drop
+}
+
+func (x *FrameSyncOption) Reset() {
+	*x = FrameSyncOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSyncOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSyncOption) ProtoMessage() {}
+
+func (x *FrameSyncOption) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSyncOption.ProtoReflect.Descriptor instead.
+func (*FrameSyncOption) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FrameSyncOption) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *FrameSyncOption) GetInputDelay() int32 {
+	if x != nil {
+		return x.InputDelay
+	}
+	return 0
+}
+
+func (x *FrameSyncOption) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *FrameSyncOption) GetStraggler() string {
+	if x != nil {
+		return x.Straggler
+	}
+	return ""
+}
+
+func (x *FrameSyncOption) GetIdleFold() int32 {
+	if x != nil {
+		return x.IdleFold
+	}
+	return 0
+}
+
+func (x *FrameSyncOption) GetLate() string {
+	if x != nil {
+		return x.Late
+	}
+	return ""
+}
+
+// CreateRoom(1)
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fps            float64          `protobuf:"fixed64,1,opt,name=fps,proto3" json:"fps,omitempty"`
+	FrameSync      *FrameSyncOption `protobuf:"bytes,2,opt,name=frame_sync,json=frameSync,proto3" json:"frame_sync,omitempty"`
+	Record         bool             `protobuf:"varint,3,opt,name=record,proto3" json:"record,omitempty"`
+	MaxSpectators  *int32           `protobuf:"varint,4,opt,name=max_spectators,json=maxSpectators,proto3,oneof" json:"max_spectators,omitempty"`    // 观战人数上限，不传时为10
+	SpectatorDelay *int32           `protobuf:"varint,5,opt,name=spectator_delay,json=spectatorDelay,proto3,oneof" json:"spectator_delay,omitempty"` // 观战延迟（毫秒），不传时为3000
+	Validator      string           `protobuf:"bytes,6,opt,name=validator,proto3" json:"validator,omitempty"`                                        // 帧操作校验器（服务器注册的扩展名称）
+	MaxInputs      int32            `protobuf:"varint,7,opt,name=max_inputs,json=maxInputs,proto3" json:"max_inputs,omitempty"`                      // 每个玩家每帧最多提交的操作数，0使用默认值8
+	MaxInputSize   int32            `protobuf:"varint,8,opt,name=max_input_size,json=maxInputSize,proto3" json:"max_input_size,omitempty"`           // 单个操作的最大字节数，0使用默认值1024
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateRoomRequest) GetFps() float64 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetFrameSync() *FrameSyncOption {
+	if x != nil {
+		return x.FrameSync
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetRecord() bool {
+	if x != nil {
+		return x.Record
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetMaxSpectators() int32 {
+	if x != nil && x.MaxSpectators != nil {
+		return *x.MaxSpectators
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetSpectatorDelay() int32 {
+	if x != nil && x.SpectatorDelay != nil {
+		return *x.SpectatorDelay
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetMaxInputs() int32 {
+	if x != nil {
+		return x.MaxInputs
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetMaxInputSize() int32 {
+	if x != nil {
+		return x.MaxInputSize
+	}
+	return 0
+}
+
+// JoinRoom(2)
+type JoinRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Spectate bool   `protobuf:"varint,3,opt,name=spectate,proto3" json:"spectate,omitempty"` // 是否以观战身份加入
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *JoinRoomRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetSpectate() bool {
+	if x != nil {
+		return x.Spectate
+	}
+	return false
+}
+
+// SwitchSeat(48)
+type SwitchSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat int32 `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+}
+
+func (x *SwitchSeatRequest) Reset() {
+	*x = SwitchSeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchSeatRequest) ProtoMessage() {}
+
+func (x *SwitchSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchSeatRequest.ProtoReflect.Descriptor instead.
+func (*SwitchSeatRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SwitchSeatRequest) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+// SendToUser(44)
+type SendToUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid  int32           `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Data *structpb.Value `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SendToUserRequest) Reset() {
+	*x = SendToUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendToUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendToUserRequest) ProtoMessage() {}
+
+func (x *SendToUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendToUserRequest.ProtoReflect.Descriptor instead.
+func (*SendToUserRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SendToUserRequest) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SendToUserRequest) GetData() *structpb.Value {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UpdateRoomOption(19)
+type UpdateRoomOptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxCounts      int32  `protobuf:"varint,1,opt,name=max_counts,json=maxCounts,proto3" json:"max_counts,omitempty"`
+	Password       string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	MaxSpectators  *int32 `protobuf:"varint,3,opt,name=max_spectators,json=maxSpectators,proto3,oneof" json:"max_spectators,omitempty"`    // 不传时不修改
+	SpectatorDelay *int32 `protobuf:"varint,4,opt,name=spectator_delay,json=spectatorDelay,proto3,oneof" json:"spectator_delay,omitempty"` // 不传时不修改
+}
+
+func (x *UpdateRoomOptionRequest) Reset() {
+	*x = UpdateRoomOptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomOptionRequest) ProtoMessage() {}
+
+func (x *UpdateRoomOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomOptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomOptionRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateRoomOptionRequest) GetMaxCounts() int32 {
+	if x != nil {
+		return x.MaxCounts
+	}
+	return 0
+}
+
+func (x *UpdateRoomOptionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdateRoomOptionRequest) GetMaxSpectators() int32 {
+	if x != nil && x.MaxSpectators != nil {
+		return *x.MaxSpectators
+	}
+	return 0
+}
+
+func (x *UpdateRoomOptionRequest) GetSpectatorDelay() int32 {
+	if x != nil && x.SpectatorDelay != nil {
+		return *x.SpectatorDelay
+	}
+	return 0
+}
+
+// KickOut(20)、GetUserDataByUID(40)
+type UidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid int32 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *UidRequest) Reset() {
+	*x = UidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UidRequest) ProtoMessage() {}
+
+func (x *UidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UidRequest.ProtoReflect.Descriptor instead.
+func (*UidRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UidRequest) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+// GetFrameAt(22)
+type GetFrameAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"` // 0表示到最新帧
+}
+
+func (x *GetFrameAtRequest) Reset() {
+	*x = GetFrameAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFrameAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrameAtRequest) ProtoMessage() {}
+
+func (x *GetFrameAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrameAtRequest.ProtoReflect.Descriptor instead.
+func (*GetFrameAtRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetFrameAtRequest) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetFrameAtRequest) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// GetRoomList(35)
+type GetRoomListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page   int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Counts int32 `protobuf:"varint,2,opt,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *GetRoomListRequest) Reset() {
+	*x = GetRoomListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoomListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomListRequest) ProtoMessage() {}
+
+func (x *GetRoomListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomListRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomListRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRoomListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRoomListRequest) GetCounts() int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return 0
+}
+
+// ListenerServer(38)、CannelListenerServer(39)
+type ListenerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op int32 `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"` // 0表示EVENT_GetServerMsg
+}
+
+func (x *ListenerRequest) Reset() {
+	*x = ListenerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerRequest) ProtoMessage() {}
+
+func (x *ListenerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerRequest.ProtoReflect.Descriptor instead.
+func (*ListenerRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListenerRequest) GetOp() int32 {
+	if x != nil {
+		return x.Op
+	}
+	return 0
+}
+
+// GetServerOldMsg(41)
+type GetServerOldMsgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts int32 `protobuf:"varint,1,opt,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *GetServerOldMsgRequest) Reset() {
+	*x = GetServerOldMsgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerOldMsgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerOldMsgRequest) ProtoMessage() {}
+
+func (x *GetServerOldMsgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerOldMsgRequest.ProtoReflect.Descriptor instead.
+func (*GetServerOldMsgRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetServerOldMsgRequest) GetCounts() int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return 0
+}
+
+// ExtendsCall(42)
+type ExtendsCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	F string          `protobuf:"bytes,1,opt,name=f,proto3" json:"f,omitempty"` // 扩展方法名称
+	D *structpb.Value `protobuf:"bytes,2,opt,name=d,proto3" json:"d,omitempty"` // 扩展方法参数
+}
+
+func (x *ExtendsCallRequest) Reset() {
+	*x = ExtendsCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendsCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendsCallRequest) ProtoMessage() {}
+
+func (x *ExtendsCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendsCallRequest.ProtoReflect.Descriptor instead.
+func (*ExtendsCallRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExtendsCallRequest) GetF() string {
+	if x != nil {
+		return x.F
+	}
+	return ""
+}
+
+func (x *ExtendsCallRequest) GetD() *structpb.Value {
+	if x != nil {
+		return x.D
+	}
+	return nil
+}
+
+// QueryRoomList(46)
+type QueryRoomListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roomids []int32 `protobuf:"varint,1,rep,packed,name=roomids,proto3" json:"roomids,omitempty"`
+}
+
+func (x *QueryRoomListRequest) Reset() {
+	*x = QueryRoomListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRoomListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRoomListRequest) ProtoMessage() {}
+
+func (x *QueryRoomListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRoomListRequest.ProtoReflect.Descriptor instead.
+func (*QueryRoomListRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryRoomListRequest) GetRoomids() []int32 {
+	if x != nil {
+		return x.Roomids
+	}
+	return nil
+}
+
+// ReportChecksum(54)
+type ReportChecksumRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	T        int32  `protobuf:"varint,1,opt,name=t,proto3" json:"t,omitempty"`              // 帧序号
+	Checksum string `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"` // 该帧的状态校验值
+}
+
+func (x *ReportChecksumRequest) Reset() {
+	*x = ReportChecksumRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportChecksumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportChecksumRequest) ProtoMessage() {}
+
+func (x *ReportChecksumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportChecksumRequest.ProtoReflect.Descriptor instead.
+func (*ReportChecksumRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReportChecksumRequest) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *ReportChecksumRequest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+// FrameCatchUp(56)
+type FrameCatchUpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From int32 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"` // 开始帧，0表示从第1帧开始
+}
+
+func (x *FrameCatchUpRequest) Reset() {
+	*x = FrameCatchUpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameCatchUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameCatchUpRequest) ProtoMessage() {}
+
+func (x *FrameCatchUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameCatchUpRequest.ProtoReflect.Descriptor instead.
+func (*FrameCatchUpRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FrameCatchUpRequest) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+// PlayReplay(58)
+type PlayReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`        // 回放ID
+	Speed int32  `protobuf:"varint,2,opt,name=speed,proto3" json:"speed,omitempty"` // 播放速度：1、2、4
+	From  int32  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`   // 快进到的帧
+}
+
+func (x *PlayReplayRequest) Reset() {
+	*x = PlayReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayReplayRequest) ProtoMessage() {}
+
+func (x *PlayReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayReplayRequest.ProtoReflect.Descriptor instead.
+func (*PlayReplayRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PlayReplayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayReplayRequest) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *PlayReplayRequest) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+// ReplayControl(59)
+type ReplayControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // pause、resume、seek、speed、stop
+	T      int32  `protobuf:"varint,2,opt,name=t,proto3" json:"t,omitempty"`          // seek跳转到的帧
+	Speed  int32  `protobuf:"varint,3,opt,name=speed,proto3" json:"speed,omitempty"`  // speed修改的播放速度
+}
+
+func (x *ReplayControlRequest) Reset() {
+	*x = ReplayControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayControlRequest) ProtoMessage() {}
+
+func (x *ReplayControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayControlRequest.ProtoReflect.Descriptor instead.
+func (*ReplayControlRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReplayControlRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ReplayControlRequest) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *ReplayControlRequest) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+// TimeSync(61)
+type TimeSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C int64 `protobuf:"varint,1,opt,name=c,proto3" json:"c,omitempty"` // 客户端发送时的时间
+}
+
+func (x *TimeSyncRequest) Reset() {
+	*x = TimeSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSyncRequest) ProtoMessage() {}
+
+func (x *TimeSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSyncRequest.ProtoReflect.Descriptor instead.
+func (*TimeSyncRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TimeSyncRequest) GetC() int64 {
+	if x != nil {
+		return x.C
+	}
+	return 0
+}
+
+// ChangeFrameRate(64)
+type ChangeFrameRateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fps float64 `protobuf:"fixed64,1,opt,name=fps,proto3" json:"fps,omitempty"`
+	At  int32   `protobuf:"varint,2,opt,name=at,proto3" json:"at,omitempty"` // 生效的帧序号，不传时为下一帧之后的一帧
+}
+
+func (x *ChangeFrameRateRequest) Reset() {
+	*x = ChangeFrameRateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeFrameRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFrameRateRequest) ProtoMessage() {}
+
+func (x *ChangeFrameRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeFrameRateRequest.ProtoReflect.Descriptor instead.
+func (*ChangeFrameRateRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangeFrameRateRequest) GetFps() float64 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+func (x *ChangeFrameRateRequest) GetAt() int32 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+// Pong(63)
+type PongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	S int64 `protobuf:"varint,1,opt,name=s,proto3" json:"s,omitempty"` // Ping中的服务器单调时间
+}
+
+func (x *PongRequest) Reset() {
+	*x = PongRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PongRequest) ProtoMessage() {}
+
+func (x *PongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PongRequest.ProtoReflect.Descriptor instead.
+func (*PongRequest) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PongRequest) GetS() int64 {
+	if x != nil {
+		return x.S
+	}
+	return 0
+}
+
+// Login(8)、ResumeSession(51)
+type LoginReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid      int32           `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Session  string          `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`   // 会话Token，用于断线后恢复会话（未开启时为空）
+	Version  int32           `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`  // 协商的协议版本
+	Ops      []int32         `protobuf:"varint,4,rep,packed,name=ops,proto3" json:"ops,omitempty"`   // 当前协议版本可调用的op
+	Features []string        `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"` // 已开启的可选功能
+	Limits   *ProtocolLimits `protobuf:"bytes,6,opt,name=limits,proto3" json:"limits,omitempty"`     // 服务器的限制参数
+}
+
+func (x *LoginReply) Reset() {
+	*x = LoginReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginReply) ProtoMessage() {}
+
+func (x *LoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
+func (*LoginReply) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LoginReply) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *LoginReply) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *LoginReply) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LoginReply) GetOps() []int32 {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *LoginReply) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *LoginReply) GetLimits() *ProtocolLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// 服务器的限制参数
+type ProtocolLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxMessageSize int32   `protobuf:"varint,1,opt,name=max_message_size,json=maxMessageSize,proto3" json:"max_message_size,omitempty"` // 单条消息的最大字节数
+	MinFps         float64 `protobuf:"fixed64,2,opt,name=min_fps,json=minFps,proto3" json:"min_fps,omitempty"`                          // 最低帧率
+	MaxFps         float64 `protobuf:"fixed64,3,opt,name=max_fps,json=maxFps,proto3" json:"max_fps,omitempty"`                          // 最高帧率
+	DefaultFps     float64 `protobuf:"fixed64,4,opt,name=default_fps,json=defaultFps,proto3" json:"default_fps,omitempty"`              // 默认帧率
+	MaxRoomCounts  int32   `protobuf:"varint,5,opt,name=max_room_counts,json=maxRoomCounts,proto3" json:"max_room_counts,omitempty"`    // 房间人数上限
+	MaxSpectators  int32   `protobuf:"varint,6,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"`      // 观战人数上限
+	MaxFramePage   int32   `protobuf:"varint,7,opt,name=max_frame_page,json=maxFramePage,proto3" json:"max_frame_page,omitempty"`       // GetFrameAt每次最多获取的帧数
+}
+
+func (x *ProtocolLimits) Reset() {
+	*x = ProtocolLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolLimits) ProtoMessage() {}
+
+func (x *ProtocolLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolLimits.ProtoReflect.Descriptor instead.
+func (*ProtocolLimits) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProtocolLimits) GetMaxMessageSize() int32 {
+	if x != nil {
+		return x.MaxMessageSize
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetMinFps() float64 {
+	if x != nil {
+		return x.MinFps
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetMaxFps() float64 {
+	if x != nil {
+		return x.MaxFps
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetDefaultFps() float64 {
+	if x != nil {
+		return x.DefaultFps
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetMaxRoomCounts() int32 {
+	if x != nil {
+		return x.MaxRoomCounts
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
+func (x *ProtocolLimits) GetMaxFramePage() int32 {
+	if x != nil {
+		return x.MaxFramePage
+	}
+	return 0
+}
+
+// GetRoomData(4)
+type RoomData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Master        *UserData                  `protobuf:"bytes,2,opt,name=master,proto3" json:"master,omitempty"`
+	Users         []*UserData                `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	Seats         map[int32]int32            `protobuf:"bytes,4,rep,name=seats,proto3" json:"seats,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 座位号 -> uid
+	Max           int32                      `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	Data          *structpb.Struct           `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`                                                                                                                         // 房间自定义数据
+	State         *structpb.Struct           `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`                                                                                                                       // 房间状态
+	UsersState    map[int32]*structpb.Struct `protobuf:"bytes,8,rep,name=users_state,json=usersState,proto3" json:"users_state,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`  // uid -> 用户状态
+	Spectators    []*UserData                `protobuf:"bytes,9,rep,name=spectators,proto3" json:"spectators,omitempty"`                                                                                                             // 观战用户
+	MaxSpectators int32                      `protobuf:"varint,10,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"`                                                                                // 观战人数上限
+	Validator     string                     `protobuf:"bytes,11,opt,name=validator,proto3" json:"validator,omitempty"`                                                                                                              // 帧操作校验器
+	MaxInputs     int32                      `protobuf:"varint,12,opt,name=max_inputs,json=maxInputs,proto3" json:"max_inputs,omitempty"`                                                                                            // 每个玩家每帧最多提交的操作数
+	MaxInputSize  int32                      `protobuf:"varint,13,opt,name=max_input_size,json=maxInputSize,proto3" json:"max_input_size,omitempty"`                                                                                 // 单个操作的最大字节数
+	Fps           float64                    `protobuf:"fixed64,14,opt,name=fps,proto3" json:"fps,omitempty"`                                                                                                                        // 当前帧率
+	RateChanges   []*FrameRateEvent          `protobuf:"bytes,15,rep,name=rate_changes,json=rateChanges,proto3" json:"rate_changes,omitempty"`                                                                                       // 本局帧同步已公布的帧率变更
+	InputStats    map[int32]*InputStats      `protobuf:"bytes,16,rep,name=input_stats,json=inputStats,proto3" json:"input_stats,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // uid -> 本局帧同步的操作到达统计
+}
+
+func (x *RoomData) Reset() {
+	*x = RoomData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomData) ProtoMessage() {}
+
+func (x *RoomData) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomData.ProtoReflect.Descriptor instead.
+func (*RoomData) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RoomData) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoomData) GetMaster() *UserData {
+	if x != nil {
+		return x.Master
+	}
+	return nil
+}
+
+func (x *RoomData) GetUsers() []*UserData {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *RoomData) GetSeats() map[int32]int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *RoomData) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *RoomData) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RoomData) GetState() *structpb.Struct {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *RoomData) GetUsersState() map[int32]*structpb.Struct {
+	if x != nil {
+		return x.UsersState
+	}
+	return nil
+}
+
+func (x *RoomData) GetSpectators() []*UserData {
+	if x != nil {
+		return x.Spectators
+	}
+	return nil
+}
+
+func (x *RoomData) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
+func (x *RoomData) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *RoomData) GetMaxInputs() int32 {
+	if x != nil {
+		return x.MaxInputs
+	}
+	return 0
+}
+
+func (x *RoomData) GetMaxInputSize() int32 {
+	if x != nil {
+		return x.MaxInputSize
+	}
+	return 0
+}
+
+func (x *RoomData) GetFps() float64 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+func (x *RoomData) GetRateChanges() []*FrameRateEvent {
+	if x != nil {
+		return x.RateChanges
+	}
+	return nil
+}
+
+func (x *RoomData) GetInputStats() map[int32]*InputStats {
+	if x != nil {
+		return x.InputStats
+	}
+	return nil
+}
+
+// 玩家的操作到达统计
+type InputStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inputs    int32   `protobuf:"varint,1,opt,name=inputs,proto3" json:"inputs,omitempty"`                   // 提交的操作数
+	Late      int32   `protobuf:"varint,2,opt,name=late,proto3" json:"late,omitempty"`                       // 晚于期望帧到达的操作数
+	Dropped   int32   `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`                 // 因迟到被丢弃的操作数
+	MaxLate   int32   `protobuf:"varint,4,opt,name=max_late,json=maxLate,proto3" json:"max_late,omitempty"`  // 最大迟到帧数
+	AvgLate   float64 `protobuf:"fixed64,5,opt,name=avg_late,json=avgLate,proto3" json:"avg_late,omitempty"` // 迟到操作的平均迟到帧数
+	Targeted  int32   `protobuf:"varint,6,opt,name=targeted,proto3" json:"targeted,omitempty"`               // 指定了期望帧的操作数
+	AvgLead   float64 `protobuf:"fixed64,7,opt,name=avg_lead,json=avgLead,proto3" json:"avg_lead,omitempty"` // 指定了期望帧的操作，期望帧相对尚未下发的第一帧的平均提前帧数（迟到为负数）
+	Delivered int32   `protobuf:"varint,8,opt,name=delivered,proto3" json:"delivered,omitempty"`             // 已随帧下发的操作数
+	AvgWait   float64 `protobuf:"fixed64,9,opt,name=avg_wait,json=avgWait,proto3" json:"avg_wait,omitempty"` // 操作从服务器收到到随帧下发的平均等待时间（毫秒）
+	MaxWait   int64   `protobuf:"varint,10,opt,name=max_wait,json=maxWait,proto3" json:"max_wait,omitempty"` // 最大等待时间（毫秒）
+}
+
+func (x *InputStats) Reset() {
+	*x = InputStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputStats) ProtoMessage() {}
+
+func (x *InputStats) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputStats.ProtoReflect.Descriptor instead.
+func (*InputStats) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InputStats) GetInputs() int32 {
+	if x != nil {
+		return x.Inputs
+	}
+	return 0
+}
+
+func (x *InputStats) GetLate() int32 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *InputStats) GetDropped() int32 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *InputStats) GetMaxLate() int32 {
+	if x != nil {
+		return x.MaxLate
+	}
+	return 0
+}
+
+func (x *InputStats) GetAvgLate() float64 {
+	if x != nil {
+		return x.AvgLate
+	}
+	return 0
+}
+
+func (x *InputStats) GetTargeted() int32 {
+	if x != nil {
+		return x.Targeted
+	}
+	return 0
+}
+
+func (x *InputStats) GetAvgLead() float64 {
+	if x != nil {
+		return x.AvgLead
+	}
+	return 0
+}
+
+func (x *InputStats) GetDelivered() int32 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *InputStats) GetAvgWait() float64 {
+	if x != nil {
+		return x.AvgWait
+	}
+	return 0
+}
+
+func (x *InputStats) GetMaxWait() int64 {
+	if x != nil {
+		return x.MaxWait
+	}
+	return 0
+}
+
+// UploadFrame(7)
+type UploadFrameReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	T int32 `protobuf:"varint,1,opt,name=t,proto3" json:"t,omitempty"` // 锁步模式下该操作生效的帧序号
+}
+
+func (x *UploadFrameReply) Reset() {
+	*x = UploadFrameReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFrameReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFrameReply) ProtoMessage() {}
+
+func (x *UploadFrameReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFrameReply.ProtoReflect.Descriptor instead.
+func (*UploadFrameReply) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UploadFrameReply) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+// FData(9)
+type FrameEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	T int32                         `protobuf:"varint,1,opt,name=t,proto3" json:"t,omitempty"`                                                                                         // 帧序号
+	D map[int32]*structpb.ListValue `protobuf:"bytes,2,rep,name=d,proto3" json:"d,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // uid -> 该帧的操作列表
+	N int32                         `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`                                                                                         // 紧凑模式：包含第t-n+1~t帧，t之前的帧均为空帧
+	R []int32                       `protobuf:"varint,4,rep,packed,name=r,proto3" json:"r,omitempty"`                                                                                  // 紧凑模式：操作与上一帧相同的uid
+}
+
+func (x *FrameEvent) Reset() {
+	*x = FrameEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameEvent) ProtoMessage() {}
+
+func (x *FrameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameEvent.ProtoReflect.Descriptor instead.
+func (*FrameEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FrameEvent) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *FrameEvent) GetD() map[int32]*structpb.ListValue {
+	if x != nil {
+		return x.D
+	}
+	return nil
+}
+
+func (x *FrameEvent) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *FrameEvent) GetR() []int32 {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+// 房间历史消息
+type RoomRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op   int32           `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
+	Data *structpb.Value `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RoomRecord) Reset() {
+	*x = RoomRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomRecord) ProtoMessage() {}
+
+func (x *RoomRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomRecord.ProtoReflect.Descriptor instead.
+func (*RoomRecord) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RoomRecord) GetOp() int32 {
+	if x != nil {
+		return x.Op
+	}
+	return 0
+}
+
+func (x *RoomRecord) GetData() *structpb.Value {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetRoomOldMessage(17)
+type RoomOldMessageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*RoomRecord `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *RoomOldMessageReply) Reset() {
+	*x = RoomOldMessageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomOldMessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomOldMessageReply) ProtoMessage() {}
+
+func (x *RoomOldMessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomOldMessageReply.ProtoReflect.Descriptor instead.
+func (*RoomOldMessageReply) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RoomOldMessageReply) GetList() []*RoomRecord {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// GetRoomList(35)
+type RoomListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OnlineCounts int32       `protobuf:"varint,1,opt,name=online_counts,json=onlineCounts,proto3" json:"online_counts,omitempty"`
+	List         []*RoomInfo `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *RoomListReply) Reset() {
+	*x = RoomListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomListReply) ProtoMessage() {}
+
+func (x *RoomListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomListReply.ProtoReflect.Descriptor instead.
+func (*RoomListReply) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RoomListReply) GetOnlineCounts() int32 {
+	if x != nil {
+		return x.OnlineCounts
+	}
+	return 0
+}
+
+func (x *RoomListReply) GetList() []*RoomInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// QueryRoomList(46)
+type QueryRoomListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*RoomInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *QueryRoomListReply) Reset() {
+	*x = QueryRoomListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRoomListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRoomListReply) ProtoMessage() {}
+
+func (x *QueryRoomListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRoomListReply.ProtoReflect.Descriptor instead.
+func (*QueryRoomListReply) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *QueryRoomListReply) GetList() []*RoomInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// GetUserDataByUID(40)
+type UserDataByUidReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid  int32            `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data *structpb.Struct `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UserDataByUidReply) Reset() {
+	*x = UserDataByUidReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDataByUidReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataByUidReply) ProtoMessage() {}
+
+func (x *UserDataByUidReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataByUidReply.ProtoReflect.Descriptor instead.
+func (*UserDataByUidReply) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UserDataByUidReply) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UserDataByUidReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserDataByUidReply) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SeatUpdate(49)
+type SeatUpdateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid     int32 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	OldSeat int32 `protobuf:"varint,2,opt,name=old_seat,json=oldSeat,proto3" json:"old_seat,omitempty"`
+	NewSeat int32 `protobuf:"varint,3,opt,name=new_seat,json=newSeat,proto3" json:"new_seat,omitempty"`
+}
+
+func (x *SeatUpdateEvent) Reset() {
+	*x = SeatUpdateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatUpdateEvent) ProtoMessage() {}
+
+func (x *SeatUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatUpdateEvent.ProtoReflect.Descriptor instead.
+func (*SeatUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SeatUpdateEvent) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SeatUpdateEvent) GetOldSeat() int32 {
+	if x != nil {
+		return x.OldSeat
+	}
+	return 0
+}
+
+func (x *SeatUpdateEvent) GetNewSeat() int32 {
+	if x != nil {
+		return x.NewSeat
+	}
+	return 0
+}
+
+// EVENT_RoomListChanged(50)
+type RoomListChangedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *RoomListChangedEvent) Reset() {
+	*x = RoomListChangedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomListChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomListChangedEvent) ProtoMessage() {}
+
+func (x *RoomListChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomListChangedEvent.ProtoReflect.Descriptor instead.
+func (*RoomListChangedEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RoomListChangedEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// Desync(55)
+type DesyncEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	T         int32            `protobuf:"varint,1,opt,name=t,proto3" json:"t,omitempty"`                                                                                                         // 帧序号
+	Uids      []int32          `protobuf:"varint,2,rep,packed,name=uids,proto3" json:"uids,omitempty"`                                                                                            // 校验值与多数玩家不一致的用户
+	Checksums map[int32]string `protobuf:"bytes,3,rep,name=checksums,proto3" json:"checksums,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // uid -> 上报的校验值
+}
+
+func (x *DesyncEvent) Reset() {
+	*x = DesyncEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DesyncEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DesyncEvent) ProtoMessage() {}
+
+func (x *DesyncEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DesyncEvent.ProtoReflect.Descriptor instead.
+func (*DesyncEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DesyncEvent) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *DesyncEvent) GetUids() []int32 {
+	if x != nil {
+		return x.Uids
+	}
+	return nil
+}
+
+func (x *DesyncEvent) GetChecksums() map[int32]string {
+	if x != nil {
+		return x.Checksums
+	}
+	return nil
+}
+
+// FrameCatchUp(56)
+type CatchUpInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From int32 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"` // 开始帧
+	T    int32 `protobuf:"varint,2,opt,name=t,proto3" json:"t,omitempty"`       // 开始追帧时的当前帧
+}
+
+func (x *CatchUpInfo) Reset() {
+	*x = CatchUpInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatchUpInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatchUpInfo) ProtoMessage() {}
+
+func (x *CatchUpInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatchUpInfo.ProtoReflect.Descriptor instead.
+func (*CatchUpInfo) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CatchUpInfo) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *CatchUpInfo) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+// CatchUpChunk(57)
+type CatchUpChunkEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From int32  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"` // 分片的开始帧
+	To   int32  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`     // 分片的结束帧（包含）
+	T    int32  `protobuf:"varint,3,opt,name=t,proto3" json:"t,omitempty"`       // 房间的当前帧
+	Last bool   `protobuf:"varint,4,opt,name=last,proto3" json:"last,omitempty"` // 是否为最后一个分片，之后的帧通过FData下发
+	Z    []byte `protobuf:"bytes,5,opt,name=z,proto3" json:"z,omitempty"`        // gzip压缩的帧数据JSON：[{t, d}]
+}
+
+func (x *CatchUpChunkEvent) Reset() {
+	*x = CatchUpChunkEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatchUpChunkEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatchUpChunkEvent) ProtoMessage() {}
+
+func (x *CatchUpChunkEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatchUpChunkEvent.ProtoReflect.Descriptor instead.
+func (*CatchUpChunkEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CatchUpChunkEvent) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *CatchUpChunkEvent) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *CatchUpChunkEvent) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *CatchUpChunkEvent) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *CatchUpChunkEvent) GetZ() []byte {
+	if x != nil {
+		return x.Z
+	}
+	return nil
+}
+
+// TimeSync(61)
+type TimeSyncReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C        int64   `protobuf:"varint,1,opt,name=c,proto3" json:"c,omitempty"`
+	Wall     int64   `protobuf:"varint,2,opt,name=wall,proto3" json:"wall,omitempty"`          // 服务器时间（Unix毫秒）
+	Mono     int64   `protobuf:"varint,3,opt,name=mono,proto3" json:"mono,omitempty"`          // 服务器单调时间（毫秒）
+	T        int32   `protobuf:"varint,4,opt,name=t,proto3" json:"t,omitempty"`                // 房间当前帧
+	Interval float64 `protobuf:"fixed64,5,opt,name=interval,proto3" json:"interval,omitempty"` // 帧间隔（毫秒）
+	Rtt      int32   `protobuf:"varint,6,opt,name=rtt,proto3" json:"rtt,omitempty"`            // 往返延迟（毫秒）
+}
+
+func (x *TimeSyncReply) Reset() {
+	*x = TimeSyncReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSyncReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSyncReply) ProtoMessage() {}
+
+func (x *TimeSyncReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSyncReply.ProtoReflect.Descriptor instead.
+func (*TimeSyncReply) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TimeSyncReply) GetC() int64 {
+	if x != nil {
+		return x.C
+	}
+	return 0
+}
+
+func (x *TimeSyncReply) GetWall() int64 {
+	if x != nil {
+		return x.Wall
+	}
+	return 0
+}
+
+func (x *TimeSyncReply) GetMono() int64 {
+	if x != nil {
+		return x.Mono
+	}
+	return 0
+}
+
+func (x *TimeSyncReply) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *TimeSyncReply) GetInterval() float64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *TimeSyncReply) GetRtt() int32 {
+	if x != nil {
+		return x.Rtt
+	}
+	return 0
+}
+
+// ChangeFrameRate(64)、FrameRateChanged(65)
+type FrameRateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fps  float64 `protobuf:"fixed64,1,opt,name=fps,proto3" json:"fps,omitempty"`   // 新的帧率
+	At   int32   `protobuf:"varint,2,opt,name=at,proto3" json:"at,omitempty"`      // 生效的帧序号
+	Prev float64 `protobuf:"fixed64,3,opt,name=prev,proto3" json:"prev,omitempty"` // 变更前的帧率
+}
+
+func (x *FrameRateEvent) Reset() {
+	*x = FrameRateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameRateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameRateEvent) ProtoMessage() {}
+
+func (x *FrameRateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameRateEvent.ProtoReflect.Descriptor instead.
+func (*FrameRateEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FrameRateEvent) GetFps() float64 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+func (x *FrameRateEvent) GetAt() int32 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *FrameRateEvent) GetPrev() float64 {
+	if x != nil {
+		return x.Prev
+	}
+	return 0
+}
+
+// Ping(62)
+type PingEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	S int64 `protobuf:"varint,1,opt,name=s,proto3" json:"s,omitempty"` // 服务器单调时间
+}
+
+func (x *PingEvent) Reset() {
+	*x = PingEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingEvent) ProtoMessage() {}
+
+func (x *PingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingEvent.ProtoReflect.Descriptor instead.
+func (*PingEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PingEvent) GetS() int64 {
+	if x != nil {
+		return x.S
+	}
+	return 0
+}
+
+// 回放中的用户
+type ReplayUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid  int32            `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Seat int32            `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	Data *structpb.Struct `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReplayUser) Reset() {
+	*x = ReplayUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayUser) ProtoMessage() {}
+
+func (x *ReplayUser) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayUser.ProtoReflect.Descriptor instead.
+func (*ReplayUser) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReplayUser) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ReplayUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplayUser) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *ReplayUser) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// PlayReplay(58)
+type ReplayMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId      int32             `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Fps         float64           `protobuf:"fixed64,3,opt,name=fps,proto3" json:"fps,omitempty"`
+	FrameSync   *FrameSyncOption  `protobuf:"bytes,4,opt,name=frame_sync,json=frameSync,proto3" json:"frame_sync,omitempty"`
+	StartTime   int64             `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 开始帧同步的时间（Unix毫秒）
+	EndTime     int64             `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 停止帧同步的时间（Unix毫秒）
+	Master      int32             `protobuf:"varint,7,opt,name=master,proto3" json:"master,omitempty"`
+	Users       []*ReplayUser     `protobuf:"bytes,8,rep,name=users,proto3" json:"users,omitempty"`
+	Seats       map[int32]int32   `protobuf:"bytes,9,rep,name=seats,proto3" json:"seats,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 座位号 -> uid
+	CustomData  *structpb.Struct  `protobuf:"bytes,10,opt,name=custom_data,json=customData,proto3" json:"custom_data,omitempty"`
+	Frames      int32             `protobuf:"varint,11,opt,name=frames,proto3" json:"frames,omitempty"`                             // 总帧数
+	RateChanges []*FrameRateEvent `protobuf:"bytes,12,rep,name=rate_changes,json=rateChanges,proto3" json:"rate_changes,omitempty"` // 帧率变更（fps为开始时的帧率）
+}
+
+func (x *ReplayMeta) Reset() {
+	*x = ReplayMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayMeta) ProtoMessage() {}
+
+func (x *ReplayMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayMeta.ProtoReflect.Descriptor instead.
+func (*ReplayMeta) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReplayMeta) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplayMeta) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *ReplayMeta) GetFps() float64 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+func (x *ReplayMeta) GetFrameSync() *FrameSyncOption {
+	if x != nil {
+		return x.FrameSync
+	}
+	return nil
+}
+
+func (x *ReplayMeta) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ReplayMeta) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ReplayMeta) GetMaster() int32 {
+	if x != nil {
+		return x.Master
+	}
+	return 0
+}
+
+func (x *ReplayMeta) GetUsers() []*ReplayUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ReplayMeta) GetSeats() map[int32]int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *ReplayMeta) GetCustomData() *structpb.Struct {
+	if x != nil {
+		return x.CustomData
+	}
+	return nil
+}
+
+func (x *ReplayMeta) GetFrames() int32 {
+	if x != nil {
+		return x.Frames
+	}
+	return 0
+}
+
+func (x *ReplayMeta) GetRateChanges() []*FrameRateEvent {
+	if x != nil {
+		return x.RateChanges
+	}
+	return nil
+}
+
+// ReplayControl(59)、ReplayState(60)
+type ReplayStateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`  // playing、paused、reset、ended、stopped
+	T     int32  `protobuf:"varint,3,opt,name=t,proto3" json:"t,omitempty"`         // 已播放的帧
+	Total int32  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // 总帧数
+	Speed int32  `protobuf:"varint,5,opt,name=speed,proto3" json:"speed,omitempty"`
+}
+
+func (x *ReplayStateEvent) Reset() {
+	*x = ReplayStateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayStateEvent) ProtoMessage() {}
+
+func (x *ReplayStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayStateEvent.ProtoReflect.Descriptor instead.
+func (*ReplayStateEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReplayStateEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplayStateEvent) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ReplayStateEvent) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+func (x *ReplayStateEvent) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReplayStateEvent) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+// MessagesDropped(53)
+type MessagesDroppedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // 本次通知丢弃的消息数量
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // 连接累计丢弃的消息数量
+}
+
+func (x *MessagesDroppedEvent) Reset() {
+	*x = MessagesDroppedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hxonline_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagesDroppedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesDroppedEvent) ProtoMessage() {}
+
+func (x *MessagesDroppedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hxonline_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesDroppedEvent.ProtoReflect.Descriptor instead.
+func (*MessagesDroppedEvent) Descriptor() ([]byte, []int) {
+	return file_hxonline_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MessagesDroppedEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MessagesDroppedEvent) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_hxonline_proto protoreflect.FileDescriptor
+
+var file_hxonline_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x78, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x75, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x22, 0x23, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xa1, 0x01, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73,
+	0x65, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x74, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x74, 0x74,
+	0x22, 0x4b, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x18, 0x0a,
+	0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xd1, 0x01, 0x0a, 0x0b, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66,
+	0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x70, 0x73, 0x1a, 0x4e, 0x0a,
+	0x0a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x68,
+	0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8d, 0x02,
+	0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xb9, 0x01,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x41, 0x63, 0x6b, 0x22, 0x58, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70,
+	0x70, 0x69, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x6e,
+	0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x61, 0x67, 0x67,
+	0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x61, 0x67,
+	0x67, 0x6c, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x6c,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x64, 0x6c, 0x65, 0x46, 0x6f, 0x6c,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xdb, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x70, 0x73, 0x12, 0x38, 0x0a,
+	0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x2a, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f,
+	0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x22, 0x59, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x22, 0x27,
+	0x0a, 0x11, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x22, 0x51, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x54,
+	0x6f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2a,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd5, 0x01, 0x0a, 0x17, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a,
+	0x0f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22,
+	0x40, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x22, 0x21, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x6f, 0x70, 0x22, 0x30, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4f, 0x6c, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x48, 0x0a, 0x12, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x73, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x66, 0x12, 0x24, 0x0a, 0x01, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x01, 0x64,
+	0x22, 0x30, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x69,
+	0x64, 0x73, 0x22, 0x41, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x29, 0x0a, 0x13, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x22, 0x4d, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22,
+	0x52, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0c, 0x0a, 0x01, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x22, 0x1f, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x01, 0x63, 0x22, 0x3a, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x66, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x70, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x61, 0x74,
+	0x22, 0x1b, 0x0a, 0x0b, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x73, 0x22, 0xb2, 0x01,
+	0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x03, 0x6f, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x70,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x70, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46,
+	0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x50, 0x61, 0x67, 0x65, 0x22, 0x91, 0x07, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x28, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x65,
+	0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x78, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a,
+	0x0b, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x66, 0x70, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66,
+	0x70, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x43, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56,
+	0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x68, 0x78, 0x6f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x02, 0x0a, 0x0a,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x76, 0x67, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61,
+	0x76, 0x67, 0x4c, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x76, 0x67, 0x4c, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x76, 0x67, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61,
+	0x76, 0x67, 0x57, 0x61, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61,
+	0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69,
+	0x74, 0x22, 0x20, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x0a, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x74,
+	0x12, 0x29, 0x0a, 0x01, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x78,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x44, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x01, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x01, 0x72, 0x1a, 0x50, 0x0a, 0x06, 0x44, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x0a, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x3f, 0x0a, 0x13, 0x52, 0x6f, 0x6f, 0x6d, 0x4f, 0x6c, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x0d, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0x3c, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x6f, 0x6d, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x22, 0x67, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x55, 0x69,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77,
+	0x5f, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x65, 0x77,
+	0x53, 0x65, 0x61, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x75, 0x69,
+	0x64, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x2e, 0x44, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x0b, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x74, 0x22, 0x67, 0x0a, 0x11, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x0c,
+	0x0a, 0x01, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74,
+	0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x7a, 0x22, 0x81,
+	0x01, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x63, 0x12, 0x12,
+	0x0a, 0x04, 0x77, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x77, 0x61,
+	0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x6d, 0x6f, 0x6e, 0x6f, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x74, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72,
+	0x74, 0x74, 0x22, 0x46, 0x0a, 0x0e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x66, 0x70, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x22, 0x19, 0x0a, 0x09, 0x50, 0x69,
+	0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x01, 0x73, 0x22, 0x73, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x2b, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xff, 0x03, 0x0a, 0x0a, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x66, 0x70, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x68, 0x78, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x05,
+	0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x78,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x74,
+	0x61, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x78,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x10,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x22, 0x42, 0x0a, 0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x44, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x15, 0x5a, 0x13, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hxonline_proto_rawDescOnce sync.Once
+	file_hxonline_proto_rawDescData = file_hxonline_proto_rawDesc
+)
+
+func file_hxonline_proto_rawDescGZIP() []byte {
+	file_hxonline_proto_rawDescOnce.Do(func() {
+		file_hxonline_proto_rawDescData = protoimpl.X.CompressGZIP(file_hxonline_proto_rawDescData)
+	})
+	return file_hxonline_proto_rawDescData
+}
+
+var file_hxonline_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_hxonline_proto_goTypes = []interface{}{
+	(*Envelope)(nil),                // 0: hxonline.Envelope
+	(*Batch)(nil),                   // 1: hxonline.Batch
+	(*ClientError)(nil),             // 2: hxonline.ClientError
+	(*UserData)(nil),                // 3: hxonline.UserData
+	(*UserPayload)(nil),             // 4: hxonline.UserPayload
+	(*RoomId)(nil),                  // 5: hxonline.RoomId
+	(*MatchRange)(nil),              // 6: hxonline.MatchRange
+	(*MatchOption)(nil),             // 7: hxonline.MatchOption
+	(*RoomInfo)(nil),                // 8: hxonline.RoomInfo
+	(*LoginRequest)(nil),            // 9: hxonline.LoginRequest
+	(*ResumeSessionRequest)(nil),    // 10: hxonline.ResumeSessionRequest
+	(*FrameSyncOption)(nil),         // 11: hxonline.FrameSyncOption
+	(*CreateRoomRequest)(nil),       // 12: hxonline.CreateRoomRequest
+	(*JoinRoomRequest)(nil),         // 13: hxonline.JoinRoomRequest
+	(*SwitchSeatRequest)(nil),       // 14: hxonline.SwitchSeatRequest
+	(*SendToUserRequest)(nil),       // 15: hxonline.SendToUserRequest
+	(*UpdateRoomOptionRequest)(nil), // 16: hxonline.UpdateRoomOptionRequest
+	(*UidRequest)(nil),              // 17: hxonline.UidRequest
+	(*GetFrameAtRequest)(nil),       // 18: hxonline.GetFrameAtRequest
+	(*GetRoomListRequest)(nil),      // 19: hxonline.GetRoomListRequest
+	(*ListenerRequest)(nil),         // 20: hxonline.ListenerRequest
+	(*GetServerOldMsgRequest)(nil),  // 21: hxonline.GetServerOldMsgRequest
+	(*ExtendsCallRequest)(nil),      // 22: hxonline.ExtendsCallRequest
+	(*QueryRoomListRequest)(nil),    // 23: hxonline.QueryRoomListRequest
+	(*ReportChecksumRequest)(nil),   // 24: hxonline.ReportChecksumRequest
+	(*FrameCatchUpRequest)(nil),     // 25: hxonline.FrameCatchUpRequest
+	(*PlayReplayRequest)(nil),       // 26: hxonline.PlayReplayRequest
+	(*ReplayControlRequest)(nil),    // 27: hxonline.ReplayControlRequest
+	(*TimeSyncRequest)(nil),         // 28: hxonline.TimeSyncRequest
+	(*ChangeFrameRateRequest)(nil),  // 29: hxonline.ChangeFrameRateRequest
+	(*PongRequest)(nil),             // 30: hxonline.PongRequest
+	(*LoginReply)(nil),              // 31: hxonline.LoginReply
+	(*ProtocolLimits)(nil),          // 32: hxonline.ProtocolLimits
+	(*RoomData)(nil),                // 33: hxonline.RoomData
+	(*InputStats)(nil),              // 34: hxonline.InputStats
+	(*UploadFrameReply)(nil),        // 35: hxonline.UploadFrameReply
+	(*FrameEvent)(nil),              // 36: hxonline.FrameEvent
+	(*RoomRecord)(nil),              // 37: hxonline.RoomRecord
+	(*RoomOldMessageReply)(nil),     // 38: hxonline.RoomOldMessageReply
+	(*RoomListReply)(nil),           // 39: hxonline.RoomListReply
+	(*QueryRoomListReply)(nil),      // 40: hxonline.QueryRoomListReply
+	(*UserDataByUidReply)(nil),      // 41: hxonline.UserDataByUidReply
+	(*SeatUpdateEvent)(nil),         // 42: hxonline.SeatUpdateEvent
+	(*RoomListChangedEvent)(nil),    // 43: hxonline.RoomListChangedEvent
+	(*DesyncEvent)(nil),             // 44: hxonline.DesyncEvent
+	(*CatchUpInfo)(nil),             // 45: hxonline.CatchUpInfo
+	(*CatchUpChunkEvent)(nil),       // 46: hxonline.CatchUpChunkEvent
+	(*TimeSyncReply)(nil),           // 47: hxonline.TimeSyncReply
+	(*FrameRateEvent)(nil),          // 48: hxonline.FrameRateEvent
+	(*PingEvent)(nil),               // 49: hxonline.PingEvent
+	(*ReplayUser)(nil),              // 50: hxonline.ReplayUser
+	(*ReplayMeta)(nil),              // 51: hxonline.ReplayMeta
+	(*ReplayStateEvent)(nil),        // 52: hxonline.ReplayStateEvent
+	(*MessagesDroppedEvent)(nil),    // 53: hxonline.MessagesDroppedEvent
+	nil,                             // 54: hxonline.MatchOption.RangeEntry
+	nil,                             // 55: hxonline.RoomData.SeatsEntry
+	nil,                             // 56: hxonline.RoomData.UsersStateEntry
+	nil,                             // 57: hxonline.RoomData.InputStatsEntry
+	nil,                             // 58: hxonline.FrameEvent.DEntry
+	nil,                             // 59: hxonline.DesyncEvent.ChecksumsEntry
+	nil,                             // 60: hxonline.ReplayMeta.SeatsEntry
+	(*structpb.Struct)(nil),         // 61: google.protobuf.Struct
+	(*structpb.Value)(nil),          // 62: google.protobuf.Value
+	(*structpb.ListValue)(nil),      // 63: google.protobuf.ListValue
+}
+var file_hxonline_proto_depIdxs = []int32{
+	61, // 0: hxonline.UserData.data:type_name -> google.protobuf.Struct
+	62, // 1: hxonline.UserPayload.data:type_name -> google.protobuf.Value
+	54, // 2: hxonline.MatchOption.range:type_name -> hxonline.MatchOption.RangeEntry
+	61, // 3: hxonline.RoomInfo.data:type_name -> google.protobuf.Struct
+	11, // 4: hxonline.CreateRoomRequest.frame_sync:type_name -> hxonline.FrameSyncOption
+	62, // 5: hxonline.SendToUserRequest.data:type_name -> google.protobuf.Value
+	62, // 6: hxonline.ExtendsCallRequest.d:type_name -> google.protobuf.Value
+	32, // 7: hxonline.LoginReply.limits:type_name -> hxonline.ProtocolLimits
+	3,  // 8: hxonline.RoomData.master:type_name -> hxonline.UserData
+	3,  // 9: hxonline.RoomData.users:type_name -> hxonline.UserData
+	55, // 10: hxonline.RoomData.seats:type_name -> hxonline.RoomData.SeatsEntry
+	61, // 11: hxonline.RoomData.data:type_name -> google.protobuf.Struct
+	61, // 12: hxonline.RoomData.state:type_name -> google.protobuf.Struct
+	56, // 13: hxonline.RoomData.users_state:type_name -> hxonline.RoomData.UsersStateEntry
+	3,  // 14: hxonline.RoomData.spectators:type_name -> hxonline.UserData
+	48, // 15: hxonline.RoomData.rate_changes:type_name -> hxonline.FrameRateEvent
+	57, // 16: hxonline.RoomData.input_stats:type_name -> hxonline.RoomData.InputStatsEntry
+	58, // 17: hxonline.FrameEvent.d:type_name -> hxonline.FrameEvent.DEntry
+	62, // 18: hxonline.RoomRecord.data:type_name -> google.protobuf.Value
+	37, // 19: hxonline.RoomOldMessageReply.list:type_name -> hxonline.RoomRecord
+	8,  // 20: hxonline.RoomListReply.list:type_name -> hxonline.RoomInfo
+	8,  // 21: hxonline.QueryRoomListReply.list:type_name -> hxonline.RoomInfo
+	61, // 22: hxonline.UserDataByUidReply.data:type_name -> google.protobuf.Struct
+	59, // 23: hxonline.DesyncEvent.checksums:type_name -> hxonline.DesyncEvent.ChecksumsEntry
+	61, // 24: hxonline.ReplayUser.data:type_name -> google.protobuf.Struct
+	11, // 25: hxonline.ReplayMeta.frame_sync:type_name -> hxonline.FrameSyncOption
+	50, // 26: hxonline.ReplayMeta.users:type_name -> hxonline.ReplayUser
+	60, // 27: hxonline.ReplayMeta.seats:type_name -> hxonline.ReplayMeta.SeatsEntry
+	61, // 28: hxonline.ReplayMeta.custom_data:type_name -> google.protobuf.Struct
+	48, // 29: hxonline.ReplayMeta.rate_changes:type_name -> hxonline.FrameRateEvent
+	6,  // 30: hxonline.MatchOption.RangeEntry.value:type_name -> hxonline.MatchRange
+	61, // 31: hxonline.RoomData.UsersStateEntry.value:type_name -> google.protobuf.Struct
+	34, // 32: hxonline.RoomData.InputStatsEntry.value:type_name -> hxonline.InputStats
+	63, // 33: hxonline.FrameEvent.DEntry.value:type_name -> google.protobuf.ListValue
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_hxonline_proto_init() }
+func file_hxonline_proto_init() {
+	if File_hxonline_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hxonline_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Batch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSyncOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchSeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendToUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomOptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFrameAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoomListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerOldMsgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendsCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRoomListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportChecksumRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameCatchUpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayReplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeFrameRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PongRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFrameReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomOldMessageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRoomListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDataByUidReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeatUpdateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomListChangedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DesyncEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatchUpInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatchUpChunkEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSyncReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameRateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayStateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hxonline_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagesDroppedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_hxonline_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_hxonline_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hxonline_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   61,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hxonline_proto_goTypes,
+		DependencyIndexes: file_hxonline_proto_depIdxs,
+		MessageInfos:      file_hxonline_proto_msgTypes,
+	}.Build()
+	File_hxonline_proto = out.File
+	file_hxonline_proto_rawDesc = nil
+	file_hxonline_proto_goTypes = nil
+	file_hxonline_proto_depIdxs = nil
+}
@@ -15,5 +15,5 @@ func main() {
 	array.Push(4)
 	array.Push(5)
 	array.Remove(3)
-	fmt.Print(array)
+	fmt.Print(array.List)
 }
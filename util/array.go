@@ -33,6 +33,15 @@ func (a *Array) Remove(o any) bool {
 	return false
 }
 
+// 复制数组，遍历时不需要持有锁
+func (a *Array) Copy() []any {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	result := make([]any, len(a.List))
+	copy(result, a.List)
+	return result
+}
+
 // 获取数组长度
 func (a *Array) Length() int {
 	a.lock.RLock()
@@ -35,6 +35,12 @@ func (m *Map) Store(key string, data any) {
 	m.lock.Unlock()
 }
 
+func (m *Map) Remove(key string) {
+	m.lock.Lock()
+	delete(m.Data, key)
+	m.lock.Unlock()
+}
+
 func (m *Map) GetData(key string, data any) any {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -0,0 +1,88 @@
+package websocketv2
+
+import (
+	"sync/atomic"
+	"websocket_server/logs"
+)
+
+// 发送通道已满（慢速客户端）时的处理策略
+type BackpressurePolicy string
+
+const (
+	DropNewest BackpressurePolicy = "drop"        // 丢弃新消息（默认）
+	DropOldest BackpressurePolicy = "drop-oldest" // 丢弃通道中最早的消息，保留新消息
+	Disconnect BackpressurePolicy = "disconnect"  // 断开慢速客户端的连接
+)
+
+// SetBackpressure 设置发送通道满时的处理策略
+func (c *WebSocket) SetBackpressure(policy BackpressurePolicy) {
+	c.optionMu.Lock()
+	defer c.optionMu.Unlock()
+	c.policy = policy
+}
+
+// SetDropNotice 设置丢弃消息后的通知消息生成方法，count为自上次通知后丢弃的消息数量
+func (c *WebSocket) SetDropNotice(notice func(count int) []byte) {
+	c.optionMu.Lock()
+	defer c.optionMu.Unlock()
+	c.dropNotice = notice
+}
+
+// Dropped 获取连接累计丢弃的消息数量
+func (c *WebSocket) Dropped() int64 {
+	return atomic.LoadInt64(&c.dropped)
+}
+
+// 将消息加入发送通道，通道满时按策略处理
+func (c *WebSocket) enqueue(queue chan *MessageByte, data []byte) {
+	message := &MessageByte{
+		callback: nil,
+		data:     data,
+	}
+	select {
+	case queue <- message:
+		return
+	default:
+	}
+	c.optionMu.RLock()
+	policy := c.policy
+	c.optionMu.RUnlock()
+	switch policy {
+	case Disconnect:
+		logs.ErrorF("send channel full, disconnect slow consumer")
+		c.onDropped()
+		c.Close()
+	case DropOldest:
+		select {
+		case <-queue:
+			c.onDropped()
+		default:
+		}
+		select {
+		case queue <- message:
+		default:
+			c.onDropped()
+		}
+	default:
+		logs.ErrorF("send channel full, dropping message")
+		c.onDropped()
+	}
+}
+
+// 记录丢弃的消息
+func (c *WebSocket) onDropped() {
+	atomic.AddInt64(&c.dropped, 1)
+	atomic.AddInt64(&c.undelivered, 1)
+}
+
+// 如果存在未通知的丢弃消息，则生成通知消息
+func (c *WebSocket) takeDropNotice() []byte {
+	c.optionMu.RLock()
+	notice := c.dropNotice
+	c.optionMu.RUnlock()
+	if notice == nil || atomic.LoadInt64(&c.undelivered) == 0 {
+		return nil
+	}
+	count := atomic.SwapInt64(&c.undelivered, 0)
+	return notice(int(count))
+}
@@ -0,0 +1,68 @@
+package websocketv2
+
+import (
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// 底层连接，负责消息帧的读写，发送队列、背压与合并逻辑由WebSocket统一处理，
+// 不同的传输方式（WebSocket、TCP、SSE）只需要实现该接口
+type Conn interface {
+	ReadFrame() ([]byte, error)                               // 读取一帧消息（阻塞），心跳帧不会返回
+	WriteFrame(data []byte, binary bool, compress bool) error // 写入一帧消息，binary与compress仅对支持的传输方式生效
+	Ping() error                                              // 发送心跳
+	Close() error                                             // 关闭连接
+	Subprotocol() string                                      // 协商的子协议（编解码器名称）
+}
+
+// 基于gorilla/websocket的连接
+type wsConn struct {
+	conn *websocket.Conn
+}
+
+func newWsConn(conn *websocket.Conn) *wsConn {
+	c := &wsConn{conn: conn}
+	conn.SetReadLimit(MaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+	return c
+}
+
+func (c *wsConn) ReadFrame() ([]byte, error) {
+	_, data, err := c.conn.ReadMessage()
+	return data, err
+}
+
+func (c *wsConn) WriteFrame(data []byte, binary bool, compress bool) error {
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	messageType := websocket.TextMessage
+	if binary {
+		messageType = websocket.BinaryMessage
+	}
+	// 仅对超过阈值的消息进行压缩，小消息压缩收益低且增加延迟
+	c.conn.EnableWriteCompression(compress)
+	w, err := c.conn.NextWriter(messageType)
+	if err != nil {
+		return err
+	}
+	w.Write(data)
+	return w.Close()
+}
+
+func (c *wsConn) Ping() error {
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return c.conn.WriteMessage(websocket.PingMessage, nil)
+}
+
+func (c *wsConn) Close() error {
+	c.conn.WriteControl(websocket.CloseMessage, []byte{}, time.Now().Add(time.Second))
+	return c.conn.Close()
+}
+
+func (c *wsConn) Subprotocol() string {
+	return c.conn.Subprotocol()
+}
@@ -1,6 +1,9 @@
 package websocketv2
 
-import "websocket_server/logs"
+import (
+	"sync"
+	"websocket_server/logs"
+)
 
 type RegisterClient struct {
 	// 客户端
@@ -29,14 +32,20 @@ func CreateServerHub() *ServerHub {
 
 var SERVER_HUB *ServerHub
 
-// Init 启动服务器Hub事件循环，处理客户端注销（全局单例，仅允许初始化一次）
+var hubOnce sync.Once
+
+// Init 创建服务器Hub并在协程中启动事件循环，处理客户端注销（全局单例，多个侦听共用，重复调用时忽略）。
+// 需要在接受连接之前同步调用，保证连接关闭时Hub已存在
 func Init() {
-	if SERVER_HUB != nil {
-		logs.ErrorF("ServerHub已初始化，忽略重复初始化")
-		return
-	}
-	SERVER_HUB = CreateServerHub()
-	for data := range SERVER_HUB.unregister {
+	hubOnce.Do(func() {
+		SERVER_HUB = CreateServerHub()
+		go SERVER_HUB.run()
+	})
+}
+
+// Hub事件循环
+func (h *ServerHub) run() {
+	for data := range h.unregister {
 		logs.InfoM("unregister", data.Client)
 		if data.Client.OnUserOutCallback != nil {
 			go data.Client.OnUserOutCallback()
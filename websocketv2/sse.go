@@ -0,0 +1,120 @@
+package websocketv2
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var errSSEClosed = errors.New("sse connection closed")
+
+// 基于HTTP的连接（用于无法使用WebSocket的环境）：服务器通过Server-Sent Events下发消息，
+// 客户端通过HTTP POST上传消息，仅支持JSON编解码器
+type SSEConn struct {
+	id   string
+	in   chan []byte   // POST上传的消息
+	out  chan []byte   // 等待写入事件流的消息，空消息表示心跳
+	done chan struct{} // 连接关闭
+	once sync.Once
+}
+
+// 创建SSE连接，id用于客户端POST上传消息时标识连接
+func NewSSEConn(id string) *SSEConn {
+	return &SSEConn{
+		id:   id,
+		in:   make(chan []byte, 64),
+		out:  make(chan []byte),
+		done: make(chan struct{}),
+	}
+}
+
+func (c *SSEConn) ReadFrame() ([]byte, error) {
+	select {
+	case data := <-c.in:
+		return data, nil
+	case <-c.done:
+		return nil, errSSEClosed
+	}
+}
+
+func (c *SSEConn) WriteFrame(data []byte, binary bool, compress bool) error {
+	timer := time.NewTimer(writeWait)
+	defer timer.Stop()
+	select {
+	case c.out <- data:
+		return nil
+	case <-c.done:
+		return errSSEClosed
+	case <-timer.C:
+		return errors.New("sse write timeout")
+	}
+}
+
+func (c *SSEConn) Ping() error {
+	return c.WriteFrame([]byte{}, false, false)
+}
+
+func (c *SSEConn) Close() error {
+	c.once.Do(func() {
+		close(c.done)
+	})
+	return nil
+}
+
+func (c *SSEConn) Subprotocol() string {
+	return "json"
+}
+
+// Post 投递客户端通过POST上传的消息
+func (c *SSEConn) Post(data []byte) error {
+	select {
+	case c.in <- data:
+		return nil
+	case <-c.done:
+		return errSSEClosed
+	}
+}
+
+// Serve 输出事件流（阻塞），直到连接关闭或客户端断开。
+// 第一个事件为`open`，data为`{"conn":"连接ID"}`，之后每条消息为一个默认事件
+func (c *SSEConn) Serve(w http.ResponseWriter, r *http.Request) {
+	defer c.Close()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("event: open\ndata: {\"conn\":\"" + c.id + "\"}\n\n"))
+	flusher.Flush()
+	for {
+		select {
+		case data := <-c.out:
+			var err error
+			if len(data) == 0 {
+				_, err = w.Write([]byte(": ping\n\n"))
+			} else {
+				// JSON编码后不包含换行，一条消息对应一行data
+				_, err = w.Write(append(append([]byte("data: "), data...), '\n', '\n'))
+			}
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-c.done:
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// 创建基于SSE的客户端
+func CreateSSEClient(conn *SSEConn) *WebSocket {
+	return newClient(conn)
+}
@@ -0,0 +1,95 @@
+package websocketv2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// 基于流的连接（TCP等），每帧消息为4字节大端长度 + 消息内容，长度为0的帧为心跳帧。
+//
+// 连接建立后客户端发送的第一帧如果是已注册的编解码器名称（如`msgpack`），则使用该编解码器，
+// 否则使用默认编解码器，且该帧按普通消息处理。
+type streamConn struct {
+	conn        net.Conn
+	subprotocol string
+	pending     []byte // 握手时读取到的第一帧普通消息
+	header      [4]byte
+}
+
+// 读取一帧消息（包括心跳帧）
+func (c *streamConn) readFrame() ([]byte, error) {
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	if _, err := io.ReadFull(c.conn, c.header[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(c.header[:])
+	if size > MaxMessageSize {
+		return nil, fmt.Errorf("frame too large: %d", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(c.conn, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (c *streamConn) ReadFrame() ([]byte, error) {
+	if c.pending != nil {
+		data := c.pending
+		c.pending = nil
+		return data, nil
+	}
+	for {
+		data, err := c.readFrame()
+		if err != nil || len(data) > 0 {
+			return data, err
+		}
+	}
+}
+
+func (c *streamConn) WriteFrame(data []byte, binary bool, compress bool) error {
+	return c.write(data)
+}
+
+func (c *streamConn) write(data []byte) error {
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	frame := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[4:], data)
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+func (c *streamConn) Ping() error {
+	return c.write(nil)
+}
+
+func (c *streamConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *streamConn) Subprotocol() string {
+	return c.subprotocol
+}
+
+// 创建基于流的客户端（TCP等），protocols为支持的编解码器名称，用于识别客户端发送的第一帧
+func CreateStreamClient(conn net.Conn, protocols []string) (*WebSocket, error) {
+	c := &streamConn{conn: conn}
+	first, err := c.ReadFrame()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	c.pending = first
+	for _, name := range protocols {
+		if string(first) == name {
+			c.subprotocol = name
+			c.pending = nil
+			break
+		}
+	}
+	return newClient(c), nil
+}
@@ -28,18 +28,49 @@ const (
 	pingPeriod = (pongWait * 9) / 10
 
 	// 对等方允许的最大消息大小（64KB，防止恶意大消息 DoS）。
-	maxMessageSize = 64 * 1024
+	MaxMessageSize = 64 * 1024
+
+	// 合并发送时，单帧最多合并的消息数量。
+	maxBatchMessages = 64
+
+	// 合并发送时，单帧合并的最大字节数（超过后剩余消息留到下一帧）。
+	maxBatchSize = 64 * 1024
 )
 
-// WebSocket包装器
+// 连接包装器，WebSocket、TCP、SSE等传输方式共用发送队列、背压与合并逻辑
 type WebSocket struct {
-	// WebSocket连接器
-	conn *websocket.Conn
+	// 底层连接
+	conn Conn
 
 	// Buffered channel of outbound messages.
 	send chan *MessageByte
 
-	Connected bool
+	// 高优先级消息通道（如帧数据、错误），写协程优先消费
+	sendHigh chan *MessageByte
+
+	// 发送通道满时的处理策略
+	policy BackpressurePolicy
+
+	// 累计丢弃的消息数量
+	dropped int64
+
+	// 尚未通知客户端的丢弃消息数量
+	undelivered int64
+
+	// 丢弃消息后的通知消息生成方法
+	dropNotice func(count int) []byte
+
+	// 是否以二进制帧发送消息（由协商的编解码器决定）
+	binary bool
+
+	// 是否压缩发送的消息（需握手时协商permessage-deflate）
+	compress bool
+
+	// 压缩的最小消息字节数
+	compressThreshold int
+
+	// 消息合并器，不为nil时，会将发送通道中已排队的多条消息合并为一帧发送
+	batcher func(messages [][]byte) []byte
 
 	// 是否已经关闭通道
 	isClosed bool
@@ -56,6 +87,9 @@ type WebSocket struct {
 	// 关闭保护锁，防止readMessage/writeMessage双协程重复清理
 	closeMu sync.Mutex
 
+	// 发送参数锁，保护binary、compress、batcher、policy等，设置方法与写协程并发访问
+	optionMu sync.RWMutex
+
 	userData map[string]any
 
 	frames *util.Array
@@ -65,16 +99,14 @@ type WebSocket struct {
 	OnWorkData func(data []byte)
 }
 
-// 发送二进制数据（非阻塞，通道满时丢弃消息防止发送端阻塞）
+// 发送二进制数据（非阻塞，通道满时按背压策略处理，防止发送端阻塞）
 func (client *WebSocket) SendBytes(data []byte) {
-	select {
-	case client.send <- &MessageByte{
-		callback: nil,
-		data:     data,
-	}:
-	default:
-		logs.ErrorF("send channel full, dropping message")
-	}
+	client.enqueue(client.send, data)
+}
+
+// 发送高优先级数据，优先于普通消息发送，且不会因普通消息积压而被丢弃
+func (client *WebSocket) SendBytesPriority(data []byte) {
+	client.enqueue(client.sendHigh, data)
 }
 
 // cleanup 安全关闭连接（幂等，仅首次调用生效）
@@ -96,33 +128,30 @@ func (c *WebSocket) cleanup() {
 	c.conn.Close()
 }
 
+// 创建WebSocket客户端，需设置回调后调用Start开始收发消息
 func CreateWebSocketClient(conn *websocket.Conn) *WebSocket {
-	client := &WebSocket{conn: conn, send: make(chan *MessageByte, 256), Connected: true, isClosed: false,
+	return newClient(newWsConn(conn))
+}
+
+func newClient(conn Conn) *WebSocket {
+	return &WebSocket{conn: conn, send: make(chan *MessageByte, 256), sendHigh: make(chan *MessageByte, 256), isClosed: false,
 		isReleased: false, isUnregister: false, createdAt: time.Now(),
 		userData: map[string]any{}, frames: util.CreateArray()}
-	go client.readMessage()
-	go client.writeMessage()
-	return client
+}
+
+// Start 开始收发消息
+func (c *WebSocket) Start() {
+	go c.readMessage()
+	go c.writeMessage()
 }
 
 func (c *WebSocket) readMessage() {
 	defer runtime.GoRecover()
 	defer c.cleanup()
-	c.conn.SetReadLimit(maxMessageSize)
-	c.conn.SetReadDeadline(time.Now().Add(pongWait))
-	c.conn.SetPongHandler(func(string) error {
-		c.conn.SetReadDeadline(time.Now().Add(pongWait))
-		return nil
-	})
 	for {
-		var data []byte
-		_, data, err := c.conn.ReadMessage()
+		data, err := c.conn.ReadFrame()
 		if err != nil {
-			if websocket.IsUnexpectedCloseError(err) {
-				logs.ErrorF("error: %v", err)
-			} else {
-				logs.ErrorF("error: %v", err)
-			}
+			logs.ErrorF("error: %v", err)
 			break
 		}
 		// 处理客户端数据
@@ -139,47 +168,32 @@ func (c *WebSocket) writeMessage() {
 	}()
 	defer c.cleanup()
 	for {
+		// 优先发送高优先级通道中的消息
 		select {
+		case message := <-c.sendHigh:
+			if !c.write(message, c.sendHigh) {
+				return
+			}
+			continue
+		default:
+		}
+		select {
+		case message := <-c.sendHigh:
+			if !c.write(message, c.sendHigh) {
+				return
+			}
 		case message, ok := <-c.send:
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if !ok {
 				// The hub closed the channel.
-				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				c.conn.Close()
 				logs.InfoM("socket closed.")
-				if message.callback != nil {
-					message.callback <- 1
-				}
-				return
-			}
-
-			w, err := c.conn.NextWriter(websocket.TextMessage)
-			if err != nil {
-				if message.callback != nil {
-					message.callback <- 1
-				}
-				log.Panicln("write err", err)
 				return
 			}
-			w.Write(message.data)
-
-			if message.callback != nil {
-				message.callback <- 0
-			}
-
-			// Add queued chat messages to the current websocket message.
-			// n := len(c.send)
-			// for i := 0; i < n; i++ {
-			// 	w.Write(newline)
-			// 	w.Write(<-c.send)
-			// }
-
-			if err := w.Close(); err != nil {
+			if !c.write(message, c.send) {
 				return
 			}
-
 		case <-ticker.C:
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			if err := c.conn.Ping(); err != nil {
 				return
 			}
 			if c.isClosed {
@@ -189,8 +203,86 @@ func (c *WebSocket) writeMessage() {
 	}
 }
 
+// 写入一条消息，开启合并时会合并queue中已排队的消息，返回false表示连接已不可用
+func (c *WebSocket) write(message *MessageByte, queue chan *MessageByte) bool {
+	c.optionMu.RLock()
+	binary, compress, threshold, batcher := c.binary, c.compress, c.compressThreshold, c.batcher
+	c.optionMu.RUnlock()
+	data := message.data
+	queued := []*MessageByte{message}
+	if batcher != nil && len(queue) > 0 {
+		// 将已排队的消息合并为一帧发送，减少高负载时的系统调用
+		frames := [][]byte{message.data}
+		size := len(message.data)
+		for len(queue) > 0 && len(frames) < maxBatchMessages && size < maxBatchSize {
+			m := <-queue
+			frames = append(frames, m.data)
+			size += len(m.data)
+			queued = append(queued, m)
+		}
+		data = batcher(frames)
+	}
+	err := c.conn.WriteFrame(data, binary, compress && len(data) >= threshold)
+	code := 0
+	if err != nil {
+		code = 1
+	}
+	for _, m := range queued {
+		if m.callback != nil {
+			m.callback <- code
+		}
+	}
+	if err != nil {
+		log.Println("write err", err)
+		return false
+	}
+	// 通知客户端已丢弃的消息数量
+	notice := c.takeDropNotice()
+	if notice != nil {
+		if err := c.conn.WriteFrame(notice, binary, false); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *WebSocket) onWork(data []byte) {
-	c.OnWorkData(data)
+	if c.OnWorkData != nil {
+		c.OnWorkData(data)
+	}
+}
+
+// SetHandler 设置消息处理与连接断开的回调
+func (c *WebSocket) SetHandler(onData func(data []byte), onClose func()) {
+	c.OnWorkData = onData
+	c.OnUserOutCallback = onClose
+}
+
+// SetBinary 设置是否以二进制帧发送消息
+func (c *WebSocket) SetBinary(binary bool) {
+	c.optionMu.Lock()
+	defer c.optionMu.Unlock()
+	c.binary = binary
+}
+
+// SetCompression 设置是否压缩发送的消息，threshold为压缩的最小消息字节数
+func (c *WebSocket) SetCompression(enabled bool, threshold int) {
+	c.optionMu.Lock()
+	defer c.optionMu.Unlock()
+	c.compress = enabled
+	c.compressThreshold = threshold
+}
+
+// SetBatcher 设置消息合并器，为nil时每条消息单独一帧发送
+func (c *WebSocket) SetBatcher(batcher func(messages [][]byte) []byte) {
+	c.optionMu.Lock()
+	defer c.optionMu.Unlock()
+	c.batcher = batcher
+}
+
+// Subprotocol 获取握手时协商的子协议
+func (c *WebSocket) Subprotocol() string {
+	return c.conn.Subprotocol()
 }
 
 // IsLoginTimeout 检查连接是否超过登录等待时间